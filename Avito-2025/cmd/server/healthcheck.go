@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"avito-2025/internal/api"
+	"avito-2025/internal/config"
+)
+
+// healthcheckTimeout — сколько ждать ответа /readyz
+const healthcheckTimeout = 5 * time.Second
+
+// runHealthcheck — подкоманда `healthcheck`: запросить /readyz у сервера,
+// запущенного с теми же настройками, на локальном адресе
+func runHealthcheck(cfg config.HTTP) error {
+	host, port, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("http.addr %q: %w", cfg.Addr, err)
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "127.0.0.1"
+	}
+
+	scheme := "http"
+	httpClient := &http.Client{Timeout: healthcheckTimeout}
+	if cfg.TLS.Enabled() {
+		// Сертификат выписан на внешнее имя, а проверяем через localhost
+		scheme = "https"
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	client, err := api.NewClientWithResponses(scheme+"://"+net.JoinHostPort(host, port), api.WithHTTPClient(httpClient))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthcheckTimeout)
+	defer cancel()
+	resp, err := client.GetReadyzWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("/readyz: %s", resp.Status())
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// lifecycle — шаги остановки сервиса. Выполняются в порядке, обратном регистрации,
+// как defer: первым регистрируется то, что нужно всем остальным (пул БД), и оно же закрывается последним.
+type lifecycle struct {
+	hooks []shutdownHook
+}
+
+type shutdownHook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// onShutdown — зарегистрировать шаг остановки
+func (l *lifecycle) onShutdown(name string, fn func(ctx context.Context) error) {
+	l.hooks = append(l.hooks, shutdownHook{name: name, fn: fn})
+}
+
+// shutdown — выполнить все шаги; ошибка одного шага не отменяет следующие
+func (l *lifecycle) shutdown(ctx context.Context) error {
+	var errs []error
+	for i := len(l.hooks) - 1; i >= 0; i-- {
+		h := l.hooks[i]
+		start := time.Now()
+		slog.Info("Остановка", "step", h.name)
+		if err := h.fn(ctx); err != nil {
+			slog.Error("Остановка: ошибка", "step", h.name, "duration", time.Since(start), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		slog.Info("Остановка: готово", "step", h.name, "duration", time.Since(start))
+	}
+	return errors.Join(errs...)
+}
@@ -1,99 +1,237 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
+	"math/rand"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"avito-2025/internal/api"
 	"avito-2025/internal/api/handlers"
+	"avito-2025/internal/api/middleware"
+	"avito-2025/internal/config"
+	"avito-2025/internal/health"
+	"avito-2025/internal/logging"
+	"avito-2025/internal/metrics"
 	"avito-2025/internal/service"
 	"avito-2025/internal/storage"
 
+	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 )
 
 func main() {
-	dbHost := os.Getenv("DB_HOST")
-	if dbHost == "" {
-		dbHost = "localhost"
+	inv, err := config.Load(os.Args[1:], os.LookupEnv, configNames)
+	if errors.Is(err, flag.ErrHelp) {
+		return
 	}
-	dbPort := os.Getenv("DB_PORT")
-	if dbPort == "" {
-		dbPort = "5432"
+	if err != nil {
+		log.Fatalf("Ошибка конфигурации: %v", err)
+	}
+	cfg := inv.Config
+
+	// server --print-config: итоговые настройки без секретов
+	if inv.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("Ошибка вывода конфигурации: %v", err)
+		}
+		return
+	}
+
+	// Дальше все логи — через slog; log.Printf из библиотек тоже попадает в него
+	slog.SetDefault(logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format))
+
+	// server migrate up|down|status|goto N — для Postgres и SQLite
+	if len(inv.Args) > 0 && inv.Args[0] == "migrate" {
+		migrator, closeDB, err := openMigrator(cfg)
+		if err != nil {
+			fatal("Не удалось подключиться к БД", err)
+		}
+
+		err = runMigrate(context.Background(), migrator, inv.Args[1:])
+		closeDB()
+		if err != nil {
+			fatal("Ошибка миграции", err)
+		}
+		return
+	}
+	// server healthcheck — проверка готовности для healthcheck в docker-compose
+	if len(inv.Args) > 0 && inv.Args[0] == "healthcheck" {
+		if err := runHealthcheck(cfg.HTTP); err != nil {
+			fatal("Сервис не готов", err)
+		}
+		return
+	}
+	if len(inv.Args) > 0 {
+		fatal("Неизвестная команда", fmt.Errorf("%q", inv.Args[0]))
+	}
+
+	if err := run(cfg); err != nil {
+		fatal("Сервис завершился с ошибкой", err)
+	}
+}
+
+// configNames — имена стратегий и уровней изоляции проверяют пакеты, которые их знают
+var configNames = config.Names{
+	Strategy: func(name string) error {
+		if !service.IsStrategy(name) {
+			return fmt.Errorf("unknown strategy %q (want random, least_loaded, round_robin or weighted_random)", name)
+		}
+		return nil
+	},
+	Isolation: func(name string) error {
+		if _, err := storage.ParseIsolation(name); err != nil {
+			return fmt.Errorf("%w (want read_committed, repeatable_read or serializable)", err)
+		}
+		return nil
+	},
+}
+
+// fatal — записать ошибку в лог и завершить процесс
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// run — поднять хранилище и HTTP-сервер и работать до SIGINT/SIGTERM.
+// При остановке сервер дожидается текущих запросов (не дольше http.shutdown_timeout),
+// и только потом закрывается пул БД — начатые транзакции успевают завершиться.
+func run(cfg config.Config) error {
+	b, err := openBackend(cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка инициализации хранилища: %w", err)
 	}
-	dbUser := os.Getenv("DB_USER")
-	if dbUser == "" {
-		dbUser = "postgres"
+
+	var lc lifecycle
+	lc.onShutdown("хранилище", func(context.Context) error { return b.close() })
+
+	checker := health.New(cfg.Health.Timeout, b.checks...)
+	m := metrics.New()
+	if b.db != nil {
+		m.RegisterDB(b.db, cfg.Storage)
 	}
-	dbPassword := os.Getenv("DB_PASSWORD")
-	if dbPassword == "" {
-		dbPassword = "postgres"
+
+	e, err := newServer(cfg, b, checker, m)
+	if err != nil {
+		return errors.Join(err, lc.shutdown(context.Background()))
 	}
-	dbName := os.Getenv("DB_NAME")
-	if dbName == "" {
-		dbName = "avito_db"
+	lc.onShutdown("HTTP-сервер", func(ctx context.Context) error {
+		err := e.Shutdown(ctx)
+		if err != nil {
+			// Не дождались — обрываем оставшиеся соединения, иначе они переживут пул БД
+			e.Close()
+		}
+		return err
+	})
+	// Выполняется первым: /readyz отвечает fail, пока сервер дожидается текущих запросов
+	lc.onShutdown("готовность", func(context.Context) error {
+		checker.SetShuttingDown()
+		return nil
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- start(e, cfg.HTTP)
+	}()
+
+	select {
+	case err = <-serveErr:
+		if err != nil {
+			err = fmt.Errorf("ошибка при запуске сервера: %w", err)
+		}
+	case <-ctx.Done():
+		slog.Info("Получен сигнал остановки, завершаем работу")
 	}
+	// Повторный сигнал завершит процесс сразу
+	stop()
 
-	// Строка подключения
-	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		dbHost, dbPort, dbUser, dbPassword, dbName)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
+	defer cancel()
+	if shutdownErr := lc.shutdown(shutdownCtx); shutdownErr != nil {
+		return errors.Join(err, fmt.Errorf("ошибка при остановке: %w", shutdownErr))
+	}
+	slog.Info("Сервис остановлен")
+	return err
+}
 
-	db, err := sql.Open("postgres", psqlInfo)
+// newServer — сервисы, проверка запросов по спецификации и роуты
+func newServer(cfg config.Config, b *backend, checker *health.Checker, m *metrics.Metrics) (*echo.Echo, error) {
+	// Стратегия выбора ревьюверов: общая и переопределения по командам
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	selectors, err := service.NewTeamSelectors(cfg.Reviewer.Strategy, cfg.Reviewer.Teams, rng, b.stores.Reviewers)
 	if err != nil {
-		log.Fatalf("Ошибка подключения к БД: %v", err)
+		return nil, fmt.Errorf("ошибка настройки стратегии ревьюверов: %w", err)
 	}
-	defer db.Close()
 
-	// Проверяем соединение
-	err = db.Ping()
+	st := b.stores
+	userService := service.NewUserService(st.Users, st.Teams)
+	teamService := service.NewTeamService(b.tx, st.Teams, st.Users, selectors, m.Domain)
+	prService := service.NewPRService(b.tx, st.PRs, st.Reviewers, st.Users, st.Events, selectors, m.Domain)
+	statsService := service.NewStatsService(b.stats, st.Teams)
+
+	server := handlers.NewServer(prService, userService, teamService, statsService, checker)
+
+	// Спецификация для проверки запросов
+	swagger, err := api.GetSwagger()
 	if err != nil {
-		log.Fatalf("Не удалось подключиться к БД: %v", err)
-	}
-	log.Println("Подключение к БД успешно!")
-
-	userRepo := storage.NewUserRepository(db)
-	teamRepo := storage.NewTeamRepository(db)
-	prRepo := storage.NewPRRepository(db)
-	prReviewerRepo := storage.NewPRReviewerRepository(db)
-
-	userService := service.NewUserService(userRepo, teamRepo)
-	teamService := service.NewTeamService(teamRepo, userRepo)
-	prService := service.NewPRService(prRepo, prReviewerRepo, userRepo)
-
-	userHandler := handlers.NewUserHandler(userService)
-	teamHandler := handlers.NewTeamHandler(teamService)
-	prHandler := handlers.NewPRHandler(prService)
-
-	mux := http.NewServeMux()
-
-	// User endpoints
-	mux.HandleFunc("POST /api/users", userHandler.CreateUser)
-	mux.HandleFunc("GET /api/users/{id}", userHandler.GetUser)
-	mux.HandleFunc("GET /api/teams/{teamName}/members", userHandler.GetTeamMembers)
-	mux.HandleFunc("PATCH /api/users/{id}/activate", userHandler.ActivateUser)
-	mux.HandleFunc("PATCH /api/users/{id}/deactivate", userHandler.DeactivateUser)
-
-	// Team endpoints
-	mux.HandleFunc("POST /api/teams", teamHandler.CreateTeam)
-	mux.HandleFunc("GET /api/teams/{teamName}", teamHandler.GetTeamByName)
-	mux.HandleFunc("GET /api/teams", teamHandler.ListTeams)
-
-	// PR endpoints
-	mux.HandleFunc("POST /api/pull-requests", prHandler.CreatePR)
-	mux.HandleFunc("GET /api/pull-requests/{id}", prHandler.GetPR)
-	mux.HandleFunc("PATCH /api/pull-requests/{id}/status", prHandler.UpdatePRStatus)
-	mux.HandleFunc("POST /api/pull-requests/{id}/reviewers", prHandler.AssignReviewer)
-	mux.HandleFunc("DELETE /api/pull-requests/{id}/reviewers/{reviewerId}", prHandler.RemoveReviewer)
-	mux.HandleFunc("GET /api/users/{userId}/reviews", prHandler.GetPRsWhereUserIsReviewer)
-
-	port := ":8080"
-	log.Printf("Сервер запущен на http://localhost%s", port)
-	log.Printf("Документация: http://localhost%s/swagger", port)
-
-	err = http.ListenAndServe(port, mux)
+		return nil, fmt.Errorf("ошибка загрузки спецификации: %w", err)
+	}
+	validator, err := middleware.OpenAPIValidator(swagger, middleware.ValidatorOptions{
+		ValidateResponses: cfg.HTTP.ValidateResponses,
+	})
 	if err != nil {
-		log.Fatalf("Ошибка при запуске сервера: %v", err)
+		return nil, fmt.Errorf("ошибка настройки валидации: %w", err)
+	}
+
+	// Роуты генерируются из openapi.yml
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
+	e.Server.ReadTimeout = cfg.HTTP.ReadTimeout
+	e.Server.WriteTimeout = cfg.HTTP.WriteTimeout
+	e.Server.IdleTimeout = cfg.HTTP.IdleTimeout
+	api.RegisterHandlers(e, server)
+	// Метрики в формате Prometheus; вне спецификации, валидатор их пропускает
+	e.GET("/metrics", echo.WrapHandler(m.Handler()))
+
+	// Middleware применяются к запросу, а не при регистрации маршрутов, поэтому
+	// метрики ставятся после RegisterHandlers: им нужны имена операций из e.Routes().
+	// RequestID — первым, чтобы ID попал и в access-лог, и в логи сервисов.
+	e.Use(middleware.RequestID())
+	e.Use(middleware.AccessLog(slog.Default()))
+	e.Use(m.Middleware(e.Routes()))
+	e.Use(validator)
+	e.Use(middleware.Actor())
+	return e, nil
+}
+
+// start — слушать http.addr до остановки; штатная остановка не ошибка
+func start(e *echo.Echo, cfg config.HTTP) error {
+	var err error
+	if cfg.TLS.Enabled() {
+		e.TLSServer.ReadTimeout = cfg.ReadTimeout
+		e.TLSServer.WriteTimeout = cfg.WriteTimeout
+		e.TLSServer.IdleTimeout = cfg.IdleTimeout
+		slog.Info("Сервер запущен", "addr", cfg.Addr, "tls", true)
+		err = e.StartTLS(cfg.Addr, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+	} else {
+		slog.Info("Сервер запущен", "addr", cfg.Addr, "tls", false)
+		err = e.Start(cfg.Addr)
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
 	}
+	return err
 }
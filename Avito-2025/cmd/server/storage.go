@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"avito-2025/internal/config"
+	"avito-2025/internal/health"
+	"avito-2025/internal/migrate"
+	"avito-2025/internal/service"
+	"avito-2025/internal/storage"
+	"avito-2025/internal/storage/memory"
+	"avito-2025/internal/storage/sqlite"
+	"avito-2025/migrations"
+)
+
+// backend — хранилища, из которых собираются сервисы
+type backend struct {
+	stores service.Stores
+	stats  service.StatsStore
+	tx     service.TxRunner
+	checks []health.Check // для /readyz и /health
+	db     *sql.DB        // для метрик пула; nil у хранилища в памяти
+	close  func() error
+}
+
+// openBackend — хранилище из cfg.Storage: postgres, sqlite или memory
+func openBackend(cfg config.Config) (*backend, error) {
+	switch cfg.Storage {
+	case config.StoragePostgres:
+		db, err := openPostgres(cfg.DB)
+		if err != nil {
+			return nil, err
+		}
+		return postgresBackend(db, cfg.DB)
+	case config.StorageSQLite:
+		db, err := openSQLite(cfg.SQLite)
+		if err != nil {
+			return nil, err
+		}
+		return sqliteBackend(db)
+	case config.StorageMemory:
+		slog.Warn("Данные хранятся в памяти и пропадут при перезапуске")
+		store := memory.New()
+		return &backend{
+			stores: store.Stores(),
+			stats:  store.Stats(),
+			tx:     store,
+			close:  func() error { return nil },
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage %q", cfg.Storage)
+	}
+}
+
+// openMigrator — мигратор для команды migrate; хранилищу в памяти миграции не нужны
+func openMigrator(cfg config.Config) (*migrate.Migrator, func() error, error) {
+	switch cfg.Storage {
+	case config.StoragePostgres:
+		db, err := openPostgres(cfg.DB)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err := migrate.New(db, migrations.FS)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return m, db.Close, nil
+	case config.StorageSQLite:
+		db, err := openSQLite(cfg.SQLite)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err := sqlite.NewMigrator(db)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		return m, db.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("migrate is not supported for storage %q", cfg.Storage)
+	}
+}
+
+// openSQLite — файл базы SQLite
+func openSQLite(cfg config.SQLite) (*sql.DB, error) {
+	db, err := sqlite.Open(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite %s: %w", cfg.Path, err)
+	}
+	slog.Info("База SQLite открыта", "path", cfg.Path)
+	return db, nil
+}
+
+// sqliteBackend — применить миграции SQLite и собрать хранилища
+func sqliteBackend(db *sql.DB) (*backend, error) {
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	if err := migrator.Up(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("apply migrations: %w", err)
+	}
+	slog.Info("Схема БД на последней версии", "version", migrator.Latest())
+
+	store := sqlite.New(db)
+	return &backend{
+		stores: store.Stores(),
+		stats:  store.Stats(),
+		tx:     store,
+		checks: sqlChecks(db, migrator),
+		db:     db,
+		close:  db.Close,
+	}, nil
+}
+
+// openPostgres — подключение к Postgres с ограничениями пула
+func openPostgres(cfg config.DB) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.ConnString())
+	if err != nil {
+		return nil, fmt.Errorf("open db: %w", err)
+	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	// Проверяем соединение
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping db: %w", err)
+	}
+	slog.Info("Подключение к Postgres установлено")
+	return db, nil
+}
+
+// postgresBackend — применить миграции, сверить схему и собрать репозитории
+func postgresBackend(db *sql.DB, cfg config.DB) (*backend, error) {
+	ctx := context.Background()
+
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	// При старте применяем недостающие миграции
+	if err := migrator.Up(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("apply migrations: %w", err)
+	}
+	slog.Info("Схема БД на последней версии", "version", migrator.Latest())
+
+	// Не обслуживаем запросы, если схема разошлась с кодом
+	if err := storage.VerifySchema(ctx, db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("verify schema: %w", err)
+	}
+
+	// Транзакции: уровень изоляции и число повторов при конфликте сериализации
+	txOpts := storage.DefaultTxOptions
+	txOpts.MaxRetries = cfg.TxRetries
+	if txOpts.Isolation, err = storage.ParseIsolation(cfg.TxIsolation); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &backend{
+		stores: service.Stores{
+			Users:     storage.NewUserRepository(db),
+			Teams:     storage.NewTeamRepository(db),
+			PRs:       storage.NewPRRepository(db),
+			Reviewers: storage.NewPRReviewerRepository(db),
+			Events:    storage.NewAssignmentEventRepository(db),
+		},
+		stats:  storage.NewStatsRepository(db),
+		tx:     service.NewPostgresTx(storage.NewTxManager(db, txOpts)),
+		checks: sqlChecks(db, migrator),
+		db:     db,
+		close:  db.Close,
+	}, nil
+}
+
+// sqlChecks — БД отвечает и схема на последней версии миграций
+func sqlChecks(db *sql.DB, migrator *migrate.Migrator) []health.Check {
+	return []health.Check{
+		{Name: "database", Run: db.PingContext},
+		{Name: "migrations", Run: func(ctx context.Context) error {
+			// Только чтение: проверка частая и не должна выполнять DDL
+			version, err := migrator.ReadVersion(ctx)
+			if err != nil {
+				return err
+			}
+			if version != migrator.Latest() {
+				return fmt.Errorf("schema version %d, want %d", version, migrator.Latest())
+			}
+			return nil
+		}},
+	}
+}
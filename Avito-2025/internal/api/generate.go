@@ -0,0 +1,3 @@
+package api
+
+//go:generate oapi-codegen -package api -generate types,client,server,spec -o types.go ../../openapi.yml
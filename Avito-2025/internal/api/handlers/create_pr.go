@@ -12,57 +12,21 @@ func (s *Server) PostPullRequestCreate(ctx echo.Context) error {
 	var req api.PostPullRequestCreateJSONBody
 
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "BAD_REQUEST",
-				Message: "invalid request body",
-			},
-		})
+		return errInvalidBody
 	}
 
-	// Валидация
-	if req.PullRequestName == "" || req.AuthorId == "" {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "BAD_REQUEST",
-				Message: "pull_request_name and author_id are required",
-			},
-		})
+	// Создаем PR; автор проверяется в сервисе
+	pr, selection, err := s.PRService.CreatePR(ctx.Request().Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
+	if err != nil {
+		return err
 	}
 
-	// Проверяем, что AuthorId валидный (пытаемся получить пользователя)
-	author, err := s.UserService.GetUser(ctx.Request().Context(), req.AuthorId)
-	if err != nil || author == nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "NOT_FOUND",
-				Message: "author not found",
-			},
-		})
+	resp := map[string]interface{}{
+		"pr": pr,
 	}
-
-	// Создаем PR
-	pr, err := s.PRService.CreatePR(ctx.Request().Context(), req.PullRequestName, req.AuthorId)
-	if err != nil {
-		return ctx.JSON(http.StatusConflict, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "PR_EXISTS",
-				Message: "PR already exists",
-			},
-		})
+	// Без кандидатов выбора не было — поле не отдаём
+	if selection != nil {
+		resp["selection"] = selection
 	}
-
-	return ctx.JSON(http.StatusCreated, pr)
+	return ctx.JSON(http.StatusCreated, resp)
 }
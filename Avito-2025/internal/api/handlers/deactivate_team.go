@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PostTeamDeactivate массовая деактивация участников команды
+func (s *Server) PostTeamDeactivate(ctx echo.Context) error {
+	var req api.PostTeamDeactivateJSONRequestBody
+
+	if err := ctx.Bind(&req); err != nil {
+		return errInvalidBody
+	}
+
+	// nil — деактивировать всю команду
+	var userIDs []string
+	if req.UserIds != nil {
+		userIDs = *req.UserIds
+	}
+
+	result, err := s.TeamService.DeactivateTeam(ctx.Request().Context(), req.TeamName, userIDs)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
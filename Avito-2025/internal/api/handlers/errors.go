@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/service"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPErrorHandler — единственное место, где ошибка превращается в api.ErrorResponse.
+// Обработчики просто возвращают ошибку сервиса или echo.HTTPError.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	ctx := c.Request().Context()
+	status, resp := errorResponse(err)
+	if status >= http.StatusInternalServerError {
+		slog.ErrorContext(ctx, "Ошибка обработки запроса", "error", err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, resp)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Не удалось отправить ответ с ошибкой", "error", err)
+	}
+}
+
+// errorResponse — статус и тело ответа по категории ошибки
+func errorResponse(err error) (int, api.ErrorResponse) {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code, ErrorResponseWithCode(string(httpErrorCode(httpErr.Code)), fmt.Sprint(httpErr.Message))
+	}
+
+	// Конкретные ошибки проверяются раньше своих категорий
+	switch {
+	case errors.Is(err, service.ErrTeamExists):
+		return http.StatusBadRequest, errorWithCode(api.TEAMEXISTS, err, service.ErrTeamExists)
+	case errors.Is(err, service.ErrPRExists):
+		return http.StatusConflict, errorWithCode(api.PREXISTS, err, service.ErrPRExists)
+	case errors.Is(err, service.ErrMerged):
+		return http.StatusConflict, errorWithCode(api.PRMERGED, err, service.ErrMerged)
+	case errors.Is(err, service.ErrNotAssigned):
+		return http.StatusConflict, errorWithCode(api.NOTASSIGNED, err, service.ErrNotAssigned)
+	case errors.Is(err, service.ErrNoCandidate):
+		return http.StatusConflict, errorWithCode(api.NOCANDIDATE, err, service.ErrNoCandidate)
+	case errors.Is(err, service.ErrNotFound):
+		return http.StatusNotFound, errorWithCode(api.NOTFOUND, err, errors.New("resource not found"))
+	case errors.Is(err, service.ErrValidation):
+		return http.StatusBadRequest, errorWithCode(api.BADREQUEST, err, service.ErrValidation)
+	case errors.Is(err, service.ErrAlreadyExists):
+		// Сервисы переводят дубликаты в ErrTeamExists/ErrPRExists; сюда попадают остальные
+		return http.StatusBadRequest, errorWithCode(api.BADREQUEST, err, errors.New("resource already exists"))
+	default:
+		// Подробности — только в лог, клиенту они ни к чему
+		return http.StatusInternalServerError, ErrorResponseWithCode(string(api.INTERNALERROR), "internal server error")
+	}
+}
+
+// errorWithCode — сообщение берётся из service.Error; у прочих ошибок,
+// например непереведённых ошибок хранилища, — из fallback
+func errorWithCode(code api.ErrorResponseErrorCode, err error, fallback error) api.ErrorResponse {
+	message := fallback.Error()
+	var svcErr *service.Error
+	if errors.As(err, &svcErr) {
+		message = svcErr.Message
+	}
+	return ErrorResponseWithCode(string(code), message)
+}
+
+// httpErrorCode — код для ошибок самого echo: неизвестный путь, неверное тело и т.п.
+func httpErrorCode(status int) api.ErrorResponseErrorCode {
+	switch {
+	case status == http.StatusNotFound:
+		return api.NOTFOUND
+	case status < http.StatusInternalServerError:
+		return api.BADREQUEST
+	default:
+		return api.INTERNALERROR
+	}
+}
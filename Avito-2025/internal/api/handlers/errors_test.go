@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/service"
+	"avito-2025/internal/storage"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestErrorResponse(t *testing.T) {
+	cases := []struct {
+		name    string
+		err     error
+		status  int
+		code    api.ErrorResponseErrorCode
+		message string
+	}{
+		{"team exists", service.ErrTeamExists, http.StatusBadRequest, api.TEAMEXISTS, "team already exists"},
+		{"PR exists", service.ErrPRExists, http.StatusConflict, api.PREXISTS, "PR already exists"},
+		{"merged", service.ErrPRMerged, http.StatusConflict, api.PRMERGED, "cannot reassign on merged PR"},
+		{"not assigned", service.ErrNotAssigned, http.StatusConflict, api.NOTASSIGNED, service.ErrNotAssigned.Error()},
+		{"no candidate", service.ErrNoCandidate, http.StatusConflict, api.NOCANDIDATE, service.ErrNoCandidate.Error()},
+		{"wrapped not found", fmt.Errorf("get history: %w", service.ErrPRNotFound), http.StatusNotFound, api.NOTFOUND, "PR not found"},
+		{"validation", service.Validation("PR name cannot be empty"), http.StatusBadRequest, api.BADREQUEST, "PR name cannot be empty"},
+		{"foreign key", fmt.Errorf("%w: pq: violates foreign key", storage.ErrNotFound), http.StatusNotFound, api.NOTFOUND, "resource not found"},
+		{"echo route", echo.ErrNotFound, http.StatusNotFound, api.NOTFOUND, "Not Found"},
+		{"bad body", errInvalidBody, http.StatusBadRequest, api.BADREQUEST, "invalid request body"},
+		{"internal", errors.New("pq: connection refused"), http.StatusInternalServerError, api.INTERNALERROR, "internal server error"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status, resp := errorResponse(c.err)
+			if status != c.status || resp.Error.Code != c.code || resp.Error.Message != c.message {
+				t.Fatalf("got %d %s %q, want %d %s %q",
+					status, resp.Error.Code, resp.Error.Message, c.status, c.code, c.message)
+			}
+		})
+	}
+}
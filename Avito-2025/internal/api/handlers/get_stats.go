@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetStatsAssignments статистика назначений ревьюверов
+func (s *Server) GetStatsAssignments(ctx echo.Context, params api.GetStatsAssignmentsParams) error {
+	filter := domain.StatsFilter{From: params.From, To: params.To}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+
+	stats, err := s.StatsService.GetAssignmentStats(ctx.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetHealthz процесс жив: отвечает, пока работает HTTP-сервер
+func (s *Server) GetHealthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, api.HealthStatus{Status: api.Ok})
+}
+
+// GetReadyz готовность принимать запросы, без подробностей
+func (s *Server) GetReadyz(ctx echo.Context) error {
+	if _, ok := s.Health.Run(ctx.Request().Context()); !ok {
+		return ctx.JSON(http.StatusServiceUnavailable, api.HealthStatus{Status: api.Fail})
+	}
+	return ctx.JSON(http.StatusOK, api.HealthStatus{Status: api.Ok})
+}
+
+// GetHealth результат каждой проверки готовности с задержкой
+func (s *Server) GetHealth(ctx echo.Context) error {
+	results, ok := s.Health.Run(ctx.Request().Context())
+
+	report := api.HealthReport{
+		Status: api.HealthReportStatusOk,
+		Checks: make([]api.HealthCheck, 0, len(results)),
+	}
+	for _, r := range results {
+		check := api.HealthCheck{
+			Name:      r.Name,
+			Status:    api.HealthCheckStatusOk,
+			LatencyMs: float64(r.Latency) / float64(time.Millisecond),
+		}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			check.Status, check.Error = api.HealthCheckStatusFail, &msg
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	status := http.StatusOK
+	if !ok {
+		report.Status, status = api.HealthReportStatusFail, http.StatusServiceUnavailable
+	}
+	return ctx.JSON(status, report)
+}
@@ -12,59 +12,16 @@ func (s *Server) PostPullRequestMerge(ctx echo.Context) error {
 	var req api.PostPullRequestMergeJSONBody
 
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "BAD_REQUEST",
-				Message: "invalid request body",
-			},
-		})
+		return errInvalidBody
 	}
 
-	// Валидация
-	if req.PullRequestId == "" {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "BAD_REQUEST",
-				Message: "pull_request_id is required",
-			},
-		})
-	}
-
-	// Проверяем существование PR
-	pr, err := s.PRService.GetPR(ctx.Request().Context(), req.PullRequestId)
-	if err != nil || pr == nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "NOT_FOUND",
-				Message: "PR not found",
-			},
-		})
-	}
-
-	// Мержим PR
-	err = s.PRService.MergePR(ctx.Request().Context(), req.PullRequestId)
+	// Мержим PR (повторный merge возвращает текущее состояние)
+	pr, err := s.PRService.MergePR(ctx.Request().Context(), req.PullRequestId)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.ErrorResponse{
-			Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			},
-		})
+		return err
 	}
 
-	// Возвращаем обновленный PR
-	updatedPR, _ := s.PRService.GetPR(ctx.Request().Context(), req.PullRequestId)
-	return ctx.JSON(http.StatusOK, updatedPR)
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pr": pr,
+	})
 }
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"avito-2025/internal/api"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetPullRequestHistory история назначений ревьюверов PR
+func (s *Server) GetPullRequestHistory(ctx echo.Context, params api.GetPullRequestHistoryParams) error {
+	events, err := s.PRService.GetHistory(ctx.Request().Context(), params.PullRequestId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"events":          events,
+	})
+}
@@ -2,26 +2,42 @@ package handlers
 
 import (
 	"avito-2025/internal/api"
+	"avito-2025/internal/health"
 	"avito-2025/internal/service"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
 )
 
+// errInvalidBody — тело запроса не разбирается в ожидаемую структуру
+var errInvalidBody = echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+
 // Server реализует интерфейс ServerInterface из OpenAPI
 type Server struct {
-	PRService   *service.PRService
-	UserService *service.UserService
-	TeamService *service.TeamService
+	PRService    *service.PRService
+	UserService  *service.UserService
+	TeamService  *service.TeamService
+	StatsService *service.StatsService
+	Health       *health.Checker
 }
 
+// Проверка на этапе компиляции, что Server реализует весь контракт
+var _ api.ServerInterface = (*Server)(nil)
+
 // NewServer конструктор
 func NewServer(
 	prService *service.PRService,
 	userService *service.UserService,
 	teamService *service.TeamService,
+	statsService *service.StatsService,
+	checker *health.Checker,
 ) *Server {
 	return &Server{
-		PRService:   prService,
-		UserService: userService,
-		TeamService: teamService,
+		PRService:    prService,
+		UserService:  userService,
+		TeamService:  teamService,
+		StatsService: statsService,
+		Health:       checker,
 	}
 }
 
@@ -1,74 +0,0 @@
-package handlers
-
-import (
-	"encoding/json"
-	"net/http"
-
-	"avito-2025/internal/service"
-)
-
-type TeamHandler struct {
-	teamService *service.TeamService
-}
-
-func NewTeamHandler(teamService *service.TeamService) *TeamHandler {
-	return &TeamHandler{teamService: teamService}
-}
-
-// CreateTeam — создать команду
-// POST /api/teams
-func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		TeamName string `json:"team_name"`
-	}
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request", http.StatusBadRequest)
-		return
-	}
-
-	team, err := h.teamService.CreateTeam(r.Context(), req.TeamName)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(team)
-}
-
-// GetTeamByName — получить команду по имени
-// GET /api/teams/{teamName}
-func (h *TeamHandler) GetTeamByName(w http.ResponseWriter, r *http.Request) {
-	teamName := r.PathValue("teamName")
-
-	team, err := h.teamService.GetTeamByName(r.Context(), teamName)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	if team == nil {
-		http.Error(w, "Team not found", http.StatusNotFound)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(team)
-}
-
-// ListTeams — получить все команды
-// GET /api/teams
-func (h *TeamHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
-	teams, err := h.teamService.ListTeams(r.Context())
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(teams)
-}
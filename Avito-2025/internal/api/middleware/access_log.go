@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AccessLog пишет по записи на запрос: метод, путь, статус, задержка.
+// Ставится после RequestID, чтобы запись получила request_id.
+func AccessLog(log *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			// Ошибку обрабатываем здесь, чтобы знать итоговый статус ответа
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			req, resp := c.Request(), c.Response()
+			level := slog.LevelInfo
+			if resp.Status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			log.LogAttrs(req.Context(), level, "HTTP-запрос",
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("route", c.Path()),
+				slog.Int("status", resp.Status),
+				slog.Int64("bytes", resp.Size),
+				slog.Duration("latency", time.Since(start)),
+				slog.String("remote_ip", c.RealIP()),
+			)
+			return nil
+		}
+	}
+}
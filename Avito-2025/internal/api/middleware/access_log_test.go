@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"avito-2025/internal/logging"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	e := echo.New()
+	e.Use(RequestID())
+	e.Use(AccessLog(logging.New(&buf, "info", logging.FormatJSON)))
+	e.GET("/users/:id", func(c echo.Context) error { return c.String(http.StatusOK, "hello") })
+	e.POST("/team/add", func(echo.Context) error { return echo.NewHTTPError(http.StatusConflict, "exists") })
+	e.GET("/boom", func(echo.Context) error { return errors.New("db is down") })
+
+	cases := []struct {
+		name   string
+		method string
+		target string
+		status int
+		route  string
+		level  string
+	}{
+		{"ok", http.MethodGet, "/users/u1?x=1", http.StatusOK, "/users/:id", "INFO"},
+		{"handled error", http.MethodPost, "/team/add", http.StatusConflict, "/team/add", "INFO"},
+		{"server error", http.MethodGet, "/boom", http.StatusInternalServerError, "/boom", "ERROR"},
+		{"unknown path", http.MethodGet, "/nope", http.StatusNotFound, "", "INFO"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			buf.Reset()
+			req := httptest.NewRequest(c.method, c.target, nil)
+			id := "req-" + strings.ReplaceAll(c.name, " ", "-")
+			req.Header.Set(RequestIDHeader, id)
+			req.RemoteAddr = "10.0.0.7:51234"
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// Ошибка обработана один раз: статус из обработчика ошибок, тело не задвоено
+			if rec.Code != c.status {
+				t.Fatalf("response status %d, want %d", rec.Code, c.status)
+			}
+
+			var entry struct {
+				Level     string  `json:"level"`
+				Msg       string  `json:"msg"`
+				Method    string  `json:"method"`
+				Path      string  `json:"path"`
+				Route     string  `json:"route"`
+				Status    int     `json:"status"`
+				Bytes     int     `json:"bytes"`
+				Latency   float64 `json:"latency"`
+				RemoteIP  string  `json:"remote_ip"`
+				RequestID string  `json:"request_id"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("want exactly one JSON entry, got %q: %v", buf.String(), err)
+			}
+
+			if entry.Level != c.level || entry.Msg != "HTTP-запрос" {
+				t.Errorf("level %s msg %q", entry.Level, entry.Msg)
+			}
+			if entry.Method != c.method || entry.Route != c.route || entry.Status != c.status {
+				t.Errorf("got %s %s %d, want %s %s %d", entry.Method, entry.Route, entry.Status, c.method, c.route, c.status)
+			}
+			if entry.Path != req.URL.Path || entry.Bytes != rec.Body.Len() || entry.Latency <= 0 {
+				t.Errorf("path %q bytes %d latency %v; body is %d bytes", entry.Path, entry.Bytes, entry.Latency, rec.Body.Len())
+			}
+			if entry.RemoteIP != "10.0.0.7" || entry.RequestID != id {
+				t.Errorf("remote_ip %q request_id %q", entry.RemoteIP, entry.RequestID)
+			}
+		})
+	}
+}
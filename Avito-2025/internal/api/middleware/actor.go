@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"avito-2025/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ActorHeader — заголовок, в котором клиент передаёт инициатора изменения
+const ActorHeader = "X-Actor"
+
+// Actor переносит инициатора из заголовка X-Actor в контекст запроса для журнала назначений
+func Actor() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if actor := c.Request().Header.Get(ActorHeader); actor != "" {
+				req := c.Request()
+				c.SetRequest(req.WithContext(service.WithActor(req.Context(), actor)))
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"avito-2025/internal/logging"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDHeader — заголовок с ID запроса; клиент может передать свой
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLen — длиннее не принимаем, чтобы клиент не раздувал логи
+const maxRequestIDLen = 128
+
+// RequestID берёт ID из X-Request-ID или генерирует новый, кладёт его в контекст
+// запроса для логов и возвращает в том же заголовке ответа
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id := c.Request().Header.Get(RequestIDHeader)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(logging.WithRequestID(req.Context(), id)))
+			c.Response().Header().Set(RequestIDHeader, id)
+			return next(c)
+		}
+	}
+}
+
+// validRequestID — непустой, не длиннее maxRequestIDLen, только видимые ASCII-символы
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"avito-2025/internal/logging"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+var generatedID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// requestIDServer — ответ с ID из контекста обработчика; обработчик пишет запись в лог
+func requestIDServer(buf *bytes.Buffer) *echo.Echo {
+	log := logging.New(buf, "debug", logging.FormatJSON)
+
+	e := echo.New()
+	e.Use(RequestID())
+	e.GET("/ping", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log.InfoContext(ctx, "обработчик")
+		return c.String(http.StatusOK, logging.RequestID(ctx))
+	})
+	return e
+}
+
+func TestRequestID(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{"client ID", "trace-42:abc", true},
+		{"max length", strings.Repeat("a", 128), true},
+		{"missing", "", false},
+		{"too long", strings.Repeat("a", 129), false},
+		{"space", "two words", false},
+		{"non-ASCII", "запрос-1", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+			if c.header != "" {
+				req.Header.Set(RequestIDHeader, c.header)
+			}
+			rec := httptest.NewRecorder()
+			requestIDServer(&buf).ServeHTTP(rec, req)
+
+			id := rec.Header().Get(RequestIDHeader)
+			if c.keep && id != c.header {
+				t.Fatalf("got %q, want the client ID %q", id, c.header)
+			}
+			if !c.keep && !generatedID.MatchString(id) {
+				t.Fatalf("got %q, want a generated ID", id)
+			}
+
+			// Тот же ID в контексте обработчика и в его записях лога
+			if rec.Body.String() != id {
+				t.Errorf("context ID %q, header %q", rec.Body.String(), id)
+			}
+			var entry map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("log entry %q: %v", buf.String(), err)
+			}
+			if entry["request_id"] != id {
+				t.Errorf("log request_id %v, want %q", entry["request_id"], id)
+			}
+		})
+	}
+}
+
+// Каждый запрос без заголовка получает свой ID
+func TestRequestIDUnique(t *testing.T) {
+	e := requestIDServer(&bytes.Buffer{})
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ping", nil))
+		id := rec.Header().Get(RequestIDHeader)
+		if seen[id] {
+			t.Fatalf("duplicate ID %q", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+)
+
+// ValidatorOptions настройки проверки запросов по спецификации
+type ValidatorOptions struct {
+	// ValidateResponses — дополнительно проверять ответы (debug-режим).
+	// Несоответствия только логируются, клиент получает исходный ответ.
+	ValidateResponses bool
+}
+
+// OpenAPIValidator проверяет каждый запрос по спецификации до вызова handlers.Server.
+// Запросы на пути, которых нет в спецификации, пропускаются без проверки.
+func OpenAPIValidator(swagger *openapi3.T, opts ValidatorOptions) (echo.MiddlewareFunc, error) {
+	// Серверы из спецификации не учитываем — сервис отдаёт API от корня
+	swagger.Servers = nil
+
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+
+	filterOpts := &openapi3filter.Options{
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			route, pathParams, err := router.FindRoute(req)
+			if err != nil {
+				// Неизвестный путь или метод — пусть ответит сам echo
+				if errors.Is(err, routers.ErrPathNotFound) || errors.Is(err, routers.ErrMethodNotAllowed) {
+					return next(c)
+				}
+				return err
+			}
+
+			reqInput := &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    filterOpts,
+			}
+			if err := openapi3filter.ValidateRequest(req.Context(), reqInput); err != nil {
+				// Ответ BAD_REQUEST соберёт handlers.HTTPErrorHandler
+				return echo.NewHTTPError(http.StatusBadRequest, validationMessage(err))
+			}
+
+			if !opts.ValidateResponses {
+				return next(c)
+			}
+
+			// Запоминаем тело ответа, чтобы проверить его после обработчика
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			if err := next(c); err != nil {
+				return err
+			}
+
+			validateResponse(req.Context(), reqInput, c.Response(), recorder.body.Bytes(), filterOpts)
+			return nil
+		}
+	}, nil
+}
+
+// validateResponse сверяет ответ со спецификацией и логирует расхождения
+func validateResponse(ctx context.Context, reqInput *openapi3filter.RequestValidationInput, resp *echo.Response, body []byte, opts *openapi3filter.Options) {
+	respInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: reqInput,
+		Status:                 resp.Status,
+		Header:                 resp.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options:                opts,
+	}
+
+	if err := openapi3filter.ValidateResponse(ctx, respInput); err != nil {
+		slog.WarnContext(ctx, "Ответ не соответствует спецификации",
+			"method", reqInput.Request.Method, "path", reqInput.Request.URL.Path, "error", err)
+	}
+}
+
+// validationMessage делает из ошибки kin-openapi короткое сообщение для клиента
+func validationMessage(err error) string {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return err.Error()
+	}
+
+	reason := reqErr.Reason
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		reason = schemaErr.Reason
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			reason = fmt.Sprintf("%s: %s", strings.Join(pointer, "."), reason)
+		}
+	} else if reqErr.Err != nil && reason == "" {
+		reason = reqErr.Err.Error()
+	}
+
+	switch {
+	case reqErr.Parameter != nil:
+		return fmt.Sprintf("parameter %q: %s", reqErr.Parameter.Name, reason)
+	case reqErr.RequestBody != nil:
+		return "request body: " + reason
+	default:
+		return reason
+	}
+}
+
+// bodyRecorder пишет ответ клиенту и параллельно копирует его в буфер
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *bodyRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *bodyRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(r.ResponseWriter).Hijack()
+}
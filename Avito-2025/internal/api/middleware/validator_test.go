@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/api/handlers"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newValidatedServer(t *testing.T) *echo.Echo {
+	t.Helper()
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := OpenAPIValidator(swagger, ValidatorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
+	e.GET("/team/get", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.POST("/team/add", func(c echo.Context) error { return c.NoContent(http.StatusCreated) })
+	e.Use(validator)
+	return e
+}
+
+func TestOpenAPIValidator(t *testing.T) {
+	e := newValidatedServer(t)
+
+	cases := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		status  int
+		message string // подстрока сообщения об ошибке; пусто — ответ без ошибки
+	}{
+		{"missing query param", http.MethodGet, "/team/get", "", http.StatusBadRequest, `parameter "team_name"`},
+		{"bad body", http.MethodPost, "/team/add", `{"team_name": 1, "members": []}`, http.StatusBadRequest, "request body: team_name"},
+		{"missing body field", http.MethodPost, "/team/add", `{"team_name": "backend"}`, http.StatusBadRequest, "request body"},
+		{"valid query", http.MethodGet, "/team/get?team_name=backend", "", http.StatusOK, ""},
+		{"valid body", http.MethodPost, "/team/add", `{"team_name": "backend", "members": [{"user_id": "u1", "username": "Alice", "is_active": true}]}`, http.StatusCreated, ""},
+		{"unknown path", http.MethodGet, "/nope", "", http.StatusNotFound, "Not Found"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest(c.method, c.target, strings.NewReader(c.body))
+			if c.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != c.status {
+				t.Fatalf("got %d, want %d: %s", rec.Code, c.status, rec.Body)
+			}
+			if c.message == "" {
+				return
+			}
+
+			var resp api.ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("error body %q: %v", rec.Body, err)
+			}
+			wantCode := api.BADREQUEST
+			if c.status == http.StatusNotFound {
+				wantCode = api.NOTFOUND
+			}
+			if resp.Error.Code != wantCode || !strings.Contains(resp.Error.Message, c.message) {
+				t.Errorf("got %s %q, want %s containing %q", resp.Error.Code, resp.Error.Message, wantCode, c.message)
+			}
+		})
+	}
+}
@@ -22,14 +22,54 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AssignmentEventEventType.
+const (
+	Assigned   AssignmentEventEventType = "assigned"
+	Reassigned AssignmentEventEventType = "reassigned"
+	Unassigned AssignmentEventEventType = "unassigned"
+)
+
+// Defines values for AssignmentEventReason.
+const (
+	Auto         AssignmentEventReason = "auto"
+	Deactivation AssignmentEventReason = "deactivation"
+	Manual       AssignmentEventReason = "manual"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST    ErrorResponseErrorCode = "BAD_REQUEST"
+	INTERNALERROR ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE   ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED   ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND      ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS      ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED      ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS    ErrorResponseErrorCode = "TEAM_EXISTS"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusFail HealthCheckStatus = "fail"
+	HealthCheckStatusOk   HealthCheckStatus = "ok"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusFail HealthReportStatus = "fail"
+	HealthReportStatusOk   HealthReportStatus = "ok"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	Fail HealthStatusStatus = "fail"
+	Ok   HealthStatusStatus = "ok"
+)
+
+// Defines values for PRAssignmentStatsStatus.
+const (
+	PRAssignmentStatsStatusMERGED PRAssignmentStatsStatus = "MERGED"
+	PRAssignmentStatsStatusOPEN   PRAssignmentStatsStatus = "OPEN"
 )
 
 // Defines values for PullRequestStatus.
@@ -40,10 +80,45 @@ const (
 
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	MERGED PullRequestShortStatus = "MERGED"
+	OPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for ReviewerSelectionStrategy.
+const (
+	LeastLoaded    ReviewerSelectionStrategy = "least_loaded"
+	Random         ReviewerSelectionStrategy = "random"
+	RoundRobin     ReviewerSelectionStrategy = "round_robin"
+	WeightedRandom ReviewerSelectionStrategy = "weighted_random"
 )
 
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	// Actor Инициатор изменения (X-Actor или system)
+	Actor     string                   `json:"actor"`
+	CreatedAt time.Time                `json:"created_at"`
+	EventType AssignmentEventEventType `json:"event_type"`
+
+	// NewReviewerId Назначенный ревьювер (assigned, reassigned)
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+
+	// OldReviewerId Снятый ревьювер (unassigned, reassigned)
+	OldReviewerId *string               `json:"old_reviewer_id,omitempty"`
+	Reason        AssignmentEventReason `json:"reason"`
+}
+
+// AssignmentEventEventType defines model for AssignmentEvent.EventType.
+type AssignmentEventEventType string
+
+// AssignmentEventReason defines model for AssignmentEvent.Reason.
+type AssignmentEventReason string
+
+// AssignmentStats defines model for AssignmentStats.
+type AssignmentStats struct {
+	PullRequests []PRAssignmentStats   `json:"pull_requests"`
+	Users        []UserAssignmentStats `json:"users"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -55,6 +130,54 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	// Error Причина отказа (только при status fail)
+	Error     *string `json:"error,omitempty"`
+	LatencyMs float64 `json:"latency_ms"`
+
+	// Name database, migrations или shutdown
+	Name   string            `json:"name"`
+	Status HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Checks []HealthCheck      `json:"checks"`
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportStatus defines model for HealthReport.Status.
+type HealthReportStatus string
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Status HealthStatusStatus `json:"status"`
+}
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// PRAssignmentStats defines model for PRAssignmentStats.
+type PRAssignmentStats struct {
+	PullRequestId   string                  `json:"pull_request_id"`
+	PullRequestName string                  `json:"pull_request_name"`
+	Reassignments   int                     `json:"reassignments"`
+	Status          PRAssignmentStatsStatus `json:"status"`
+
+	// TeamName Команда автора
+	TeamName string `json:"team_name"`
+
+	// TimeToMergeSeconds От создания до мержа; null, пока PR не смержен
+	TimeToMergeSeconds *int64 `json:"time_to_merge_seconds"`
+}
+
+// PRAssignmentStatsStatus defines model for PRAssignmentStats.Status.
+type PRAssignmentStatsStatus string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
@@ -81,12 +204,44 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewerLoad defines model for ReviewerLoad.
+type ReviewerLoad struct {
+	// OpenReviews Число OPEN PR на ревью в момент выбора
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+}
+
+// ReviewerReassignment defines model for ReviewerReassignment.
+type ReviewerReassignment struct {
+	// NewReviewerId Новый ревьювер; null — замены не нашлось и слот освобождён
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// ReviewerSelection Как были выбраны ревьюверы
+type ReviewerSelection struct {
+	// CandidateLoads Нагрузка кандидатов, на которую опиралась стратегия (нет у стратегий без учёта нагрузки)
+	CandidateLoads *[]ReviewerLoad           `json:"candidate_loads,omitempty"`
+	Strategy       ReviewerSelectionStrategy `json:"strategy"`
+}
+
+// ReviewerSelectionStrategy defines model for ReviewerSelection.Strategy.
+type ReviewerSelectionStrategy string
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamDeactivationResult defines model for TeamDeactivationResult.
+type TeamDeactivationResult struct {
+	DeactivatedUserIds []string               `json:"deactivated_user_ids"`
+	Reassignments      []ReviewerReassignment `json:"reassignments"`
+	TeamName           string                 `json:"team_name"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -102,12 +257,33 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserAssignmentStats defines model for UserAssignmentStats.
+type UserAssignmentStats struct {
+	// MergedReviewed Смерженные PR, где пользователь был ревьювером
+	MergedReviewed int `json:"merged_reviewed"`
+
+	// OpenReviews Назначен сейчас на OPEN PR; от периода не зависит
+	OpenReviews int `json:"open_reviews"`
+
+	// ReassignedAway Сколько раз ревью с пользователя сняли
+	ReassignedAway int    `json:"reassigned_away"`
+	TeamName       string `json:"team_name"`
+
+	// TotalAssignments Назначения из журнала, включая полученные переназначением и потом снятые
+	TotalAssignments int    `json:"total_assignments"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
 	AuthorId        string `json:"author_id"`
@@ -115,6 +291,11 @@ type PostPullRequestCreateJSONBody struct {
 	PullRequestName string `json:"pull_request_name"`
 }
 
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
@@ -126,6 +307,21 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// GetStatsAssignmentsParams defines parameters for GetStatsAssignments.
+type GetStatsAssignmentsParams struct {
+	TeamName *string    `form:"team_name,omitempty" json:"team_name,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
+type PostTeamDeactivateJSONBody struct {
+	TeamName string `json:"team_name"`
+
+	// UserIds Кого деактивировать; если не задано — всю команду
+	UserIds *[]string `json:"user_ids,omitempty"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
@@ -156,6 +352,9 @@ type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
@@ -232,11 +431,20 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealthz request
+	GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestCreateWithBody request with any body
 	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPullRequestHistory request
+	GetPullRequestHistory(ctx context.Context, params *GetPullRequestHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPullRequestMergeWithBody request with any body
 	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -247,11 +455,22 @@ type ClientInterface interface {
 
 	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetReadyz request
+	GetReadyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsAssignments request
+	GetStatsAssignments(ctx context.Context, params *GetStatsAssignmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostTeamAddWithBody request with any body
 	PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostTeamDeactivateWithBody request with any body
+	PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTeamGet request
 	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -264,6 +483,30 @@ type ClientInterface interface {
 	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -288,6 +531,18 @@ func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequest
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetPullRequestHistory(ctx context.Context, params *GetPullRequestHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestHistoryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -336,6 +591,30 @@ func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullReque
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetReadyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsAssignments(ctx context.Context, params *GetStatsAssignmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsAssignmentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -360,6 +639,30 @@ func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTeamGetRequest(c.Server, params)
 	if err != nil {
@@ -408,6 +711,60 @@ func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsAc
 	return c.Client.Do(req)
 }
 
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthzRequest generates requests for GetHealthz
+func NewGetHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
 func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -448,6 +805,51 @@ func NewPostPullRequestCreateRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
+// NewGetPullRequestHistoryRequest generates requests for GetPullRequestHistory
+func NewGetPullRequestHistoryRequest(server string, params *GetPullRequestHistoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, params.PullRequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
 func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -528,19 +930,8 @@ func NewPostPullRequestReassignRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
-func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
-func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetReadyzRequest generates requests for GetReadyz
+func NewGetReadyzRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -548,7 +939,7 @@ func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/team/add")
+	operationPath := fmt.Sprintf("/readyz")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -558,18 +949,16 @@ func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetTeamGetRequest generates requests for GetTeamGet
-func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+// NewGetStatsAssignmentsRequest generates requests for GetStatsAssignments
+func NewGetStatsAssignmentsRequest(server string, params *GetStatsAssignmentsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -577,7 +966,168 @@ func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/team/get")
+	operationPath := fmt.Sprintf("/stats/assignments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamDeactivateRequest calls the generic PostTeamDeactivate builder with application/json body
+func NewPostTeamDeactivateRequest(server string, body PostTeamDeactivateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamDeactivateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamDeactivateRequestWithBody generates requests for PostTeamDeactivate with any type of body
+func NewPostTeamDeactivateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/deactivate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -741,11 +1291,20 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetHealthzWithResponse request
+	GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error)
+
 	// PostPullRequestCreateWithBodyWithResponse request with any body
 	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
 
 	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
 
+	// GetPullRequestHistoryWithResponse request
+	GetPullRequestHistoryWithResponse(ctx context.Context, params *GetPullRequestHistoryParams, reqEditors ...RequestEditorFn) (*GetPullRequestHistoryResponse, error)
+
 	// PostPullRequestMergeWithBodyWithResponse request with any body
 	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
 
@@ -756,11 +1315,22 @@ type ClientWithResponsesInterface interface {
 
 	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
 
+	// GetReadyzWithResponse request
+	GetReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyzResponse, error)
+
+	// GetStatsAssignmentsWithResponse request
+	GetStatsAssignmentsWithResponse(ctx context.Context, params *GetStatsAssignmentsParams, reqEditors ...RequestEditorFn) (*GetStatsAssignmentsResponse, error)
+
 	// PostTeamAddWithBodyWithResponse request with any body
 	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
 
 	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
 
+	// PostTeamDeactivateWithBodyWithResponse request with any body
+	PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
+	PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
 	// GetTeamGetWithResponse request
 	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
 
@@ -773,12 +1343,61 @@ type ClientWithResponsesInterface interface {
 	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
 }
 
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthReport
+	JSON503      *HealthReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostPullRequestCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON201      *struct {
 		Pr *PullRequest `json:"pr,omitempty"`
+
+		// Selection Как были выбраны ревьюверы
+		Selection *ReviewerSelection `json:"selection,omitempty"`
 	}
+	JSON400 *BadRequest
 	JSON404 *ErrorResponse
 	JSON409 *ErrorResponse
 }
@@ -799,12 +1418,40 @@ func (r PostPullRequestCreateResponse) StatusCode() int {
 	return 0
 }
 
+type GetPullRequestHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Events        []AssignmentEvent `json:"events"`
+		PullRequestId string            `json:"pull_request_id"`
+	}
+	JSON400 *BadRequest
+	JSON404 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostPullRequestMergeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Pr *PullRequest `json:"pr,omitempty"`
 	}
+	JSON400 *BadRequest
 	JSON404 *ErrorResponse
 }
 
@@ -832,7 +1479,11 @@ type PostPullRequestReassignResponse struct {
 
 		// ReplacedBy user_id нового ревьювера
 		ReplacedBy string `json:"replaced_by"`
+
+		// Selection Как были выбраны ревьюверы
+		Selection *ReviewerSelection `json:"selection,omitempty"`
 	}
+	JSON400 *BadRequest
 	JSON404 *ErrorResponse
 	JSON409 *ErrorResponse
 }
@@ -853,6 +1504,53 @@ func (r PostPullRequestReassignResponse) StatusCode() int {
 	return 0
 }
 
+type GetReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+	JSON503      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsAssignmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssignmentStats
+	JSON400      *BadRequest
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsAssignmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsAssignmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostTeamAddResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -878,10 +1576,35 @@ func (r PostTeamAddResponse) StatusCode() int {
 	return 0
 }
 
+type PostTeamDeactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamDeactivationResult
+	JSON400      *BadRequest
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamDeactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamDeactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTeamGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *Team
+	JSON400      *BadRequest
 	JSON404      *ErrorResponse
 }
 
@@ -908,6 +1631,8 @@ type GetUsersGetReviewResponse struct {
 		PullRequests []PullRequestShort `json:"pull_requests"`
 		UserId       string             `json:"user_id"`
 	}
+	JSON400 *BadRequest
+	JSON404 *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
@@ -932,6 +1657,7 @@ type PostUsersSetIsActiveResponse struct {
 	JSON200      *struct {
 		User *User `json:"user,omitempty"`
 	}
+	JSON400 *BadRequest
 	JSON404 *ErrorResponse
 }
 
@@ -951,6 +1677,24 @@ func (r PostUsersSetIsActiveResponse) StatusCode() int {
 	return 0
 }
 
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetHealthzWithResponse request returning *GetHealthzResponse
+func (c *ClientWithResponses) GetHealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthzResponse, error) {
+	rsp, err := c.GetHealthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthzResponse(rsp)
+}
+
 // PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
 func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
 	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
@@ -968,7 +1712,16 @@ func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Cont
 	return ParsePostPullRequestCreateResponse(rsp)
 }
 
-// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+// GetPullRequestHistoryWithResponse request returning *GetPullRequestHistoryResponse
+func (c *ClientWithResponses) GetPullRequestHistoryWithResponse(ctx context.Context, params *GetPullRequestHistoryParams, reqEditors ...RequestEditorFn) (*GetPullRequestHistoryResponse, error) {
+	rsp, err := c.GetPullRequestHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestHistoryResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
 func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
 	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
@@ -1002,6 +1755,24 @@ func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Co
 	return ParsePostPullRequestReassignResponse(rsp)
 }
 
+// GetReadyzWithResponse request returning *GetReadyzResponse
+func (c *ClientWithResponses) GetReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadyzResponse, error) {
+	rsp, err := c.GetReadyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadyzResponse(rsp)
+}
+
+// GetStatsAssignmentsWithResponse request returning *GetStatsAssignmentsResponse
+func (c *ClientWithResponses) GetStatsAssignmentsWithResponse(ctx context.Context, params *GetStatsAssignmentsParams, reqEditors ...RequestEditorFn) (*GetStatsAssignmentsResponse, error) {
+	rsp, err := c.GetStatsAssignments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsAssignmentsResponse(rsp)
+}
+
 // PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
 func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
 	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
@@ -1019,6 +1790,23 @@ func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body
 	return ParsePostTeamAddResponse(rsp)
 }
 
+// PostTeamDeactivateWithBodyWithResponse request with arbitrary body returning *PostTeamDeactivateResponse
+func (c *ClientWithResponses) PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
 // GetTeamGetWithResponse request returning *GetTeamGetResponse
 func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
 	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
@@ -1054,6 +1842,65 @@ func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Conte
 	return ParsePostUsersSetIsActiveResponse(rsp)
 }
 
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthzResponse parses an HTTP response from a GetHealthzWithResponse call
+func ParseGetHealthzResponse(rsp *http.Response) (*GetHealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
 func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1071,12 +1918,22 @@ func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCre
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest struct {
 			Pr *PullRequest `json:"pr,omitempty"`
+
+			// Selection Как были выбраны ревьюверы
+			Selection *ReviewerSelection `json:"selection,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1096,6 +1953,49 @@ func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCre
 	return response, nil
 }
 
+// ParseGetPullRequestHistoryResponse parses an HTTP response from a GetPullRequestHistoryWithResponse call
+func ParseGetPullRequestHistoryResponse(rsp *http.Response) (*GetPullRequestHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Events        []AssignmentEvent `json:"events"`
+			PullRequestId string            `json:"pull_request_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
 func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1119,6 +2019,13 @@ func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMerg
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1151,12 +2058,22 @@ func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestR
 
 			// ReplacedBy user_id нового ревьювера
 			ReplacedBy string `json:"replaced_by"`
+
+			// Selection Как были выбраны ревьюверы
+			Selection *ReviewerSelection `json:"selection,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1176,6 +2093,79 @@ func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestR
 	return response, nil
 }
 
+// ParseGetReadyzResponse parses an HTTP response from a GetReadyzWithResponse call
+func ParseGetReadyzResponse(rsp *http.Response) (*GetReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsAssignmentsResponse parses an HTTP response from a GetStatsAssignmentsWithResponse call
+func ParseGetStatsAssignmentsResponse(rsp *http.Response) (*GetStatsAssignmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsAssignmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssignmentStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
 func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1211,6 +2201,46 @@ func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error)
 	return response, nil
 }
 
+// ParsePostTeamDeactivateResponse parses an HTTP response from a PostTeamDeactivateWithResponse call
+func ParsePostTeamDeactivateResponse(rsp *http.Response) (*PostTeamDeactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamDeactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamDeactivationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
 func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1232,6 +2262,13 @@ func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1268,6 +2305,20 @@ func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewRespo
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
@@ -1296,6 +2347,13 @@ func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiv
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1310,18 +2368,36 @@ func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiv
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Подробный результат проверок готовности с задержкой каждой
+	// (GET /health)
+	GetHealth(ctx echo.Context) error
+	// Процесс жив (liveness)
+	// (GET /healthz)
+	GetHealthz(ctx echo.Context) error
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// История назначений ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
+	// Сервис готов принимать запросы (readiness)
+	// (GET /readyz)
+	GetReadyz(ctx echo.Context) error
+	// Статистика назначений ревьюверов по пользователям и PR
+	// (GET /stats/assignments)
+	GetStatsAssignments(ctx echo.Context, params GetStatsAssignmentsParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
+	// Деактивировать участников команды и переназначить их открытые ревью
+	// (POST /team/deactivate)
+	PostTeamDeactivate(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
@@ -1338,6 +2414,24 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealth(ctx)
+	return err
+}
+
+// GetHealthz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthz(ctx)
+	return err
+}
+
 // PostPullRequestCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
@@ -1347,6 +2441,24 @@ func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	return err
 }
 
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
@@ -1365,6 +2477,47 @@ func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error
 	return err
 }
 
+// GetReadyz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReadyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReadyz(ctx)
+	return err
+}
+
+// GetStatsAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsAssignments(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsAssignmentsParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsAssignments(ctx, params)
+	return err
+}
+
 // PostTeamAdd converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
@@ -1374,6 +2527,15 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamDeactivate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamDeactivate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamDeactivate(ctx)
+	return err
+}
+
 // GetTeamGet converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
@@ -1447,10 +2609,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/health", wrapper.GetHealth)
+	router.GET(baseURL+"/healthz", wrapper.GetHealthz)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(baseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.GET(baseURL+"/readyz", wrapper.GetReadyz)
+	router.GET(baseURL+"/stats/assignments", wrapper.GetStatsAssignments)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(baseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
@@ -1460,42 +2628,84 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xabW8bxxH+K4ttgTgALVK0XaD8xtiKqg+WWYoGigqCsOKtpEvuzXd7agSDgF7Suq2M",
-	"qvlWBEiMwH+ApsWK1gv1F2b/UTG7R94deTxRpiwX+WJTx73d2dlnnnlmli9p07U91+GOCGjlJfWYz2wu",
-	"uK/+anBmLzOb/zHk/i4+MHjQ9E1PmK5DKxTewiX04AzacC5fwyX0oUugBxfymMAZ9OEC2nAJJ/KIFqiJ",
-	"b7xQExWow2xOK1RwZq+rzwXq8xeh6XODVoQf8gINmtvcZrio2PVwcCB809mirVaBPg+4v2RMsuo/cAJd",
-	"uJQH0JPfa/vkAfTlHoEr6CtTT6EPHfW4C+fyeIJ5YcD9ddO4kXGtwZfKgQu+7/p1HniuE3B8wL9jtmfp",
-	"j/gdfmi6Bk6x/Kyx/vWz58tPaIHaPAjYFj71eeCGfpMTxxVk0w0dQ3nA812P+8LkQWqq9GM98UvKndCm",
-	"lVXaWKg+XV/409JKY4UWaK2e+vx0ob64gGujHdWVlaXF5ejP9cfV5SdLT6qNhehbbeVaYXTzCbuzTi12",
-	"4qo2LR4fz+VufMObYmy83uH4sAKthZZV5y9CHohxD7AgMLccbqz7fMfkf4lgncZLdMoELqENp/ivfIX4",
-	"gUt5JP9K5B50oSNfy39BB7pyD5FD7pXm5spfImwEt4OM7Q4NZb7PdvFvFoptV8Epa3TT50xwo6r2sOn6",
-	"NhO0Qg0m+H1hqvhwQstiGwgdDcEM3/tbs83ghZa17mtfTjI0NUbHScaoQDARBknsPastLNMCjVA2jp2R",
-	"8x41JWvhpE+HSxayzvwa3Kxsu34WeHJP7NfgrCy/IOeP+8Lm9kYUPUPI/9bnm7RCf1OMU0gx4r4izvJU",
-	"vZMVCzHvX0sUyRQxMGKS2dGCY8abwTprCnMnudyG61qcOfjqgOazzga/m87QOFkM3ykkVs6yGdPYja3N",
-	"890n3UvyJPL2hZOZzqarljEF8g2t1Uk9ikZSVfFpc0eQFe7vmE1O7jV4IEiDBd8WyNfMski5VH6E9LrD",
-	"/UCz9Pxcaa6Eu3A97jDPpBX6YK409wDBzsS28lzRi2O6qBlVudfVqQGdzJD0lww0yQ1EggMe6+HaDzwQ",
-	"X7nGrs6hjuCOep95nmU21QzFbwK0KpXPE3RBw3mawRDU8+/Pl0rzmQFaoVXDIAFnfnObtpIS43Ow0owM",
-	"k42KtIpSD7QyUhsrl+Zv5nDPn5TiV2lYRvA+QENu81xistYc3co5KG1eHkkmpUurlemytFSp1Ynchz6c",
-	"wgkqazzMh6WHU3gttjHPnrRazVgf/g0dLaWLSX0PbRRPXa2gPkTq+0hb9/ubnemoKE6K1FgU1+rENAiz",
-	"fM6MXcK/MwMRjJzFTPtEPx/Cf6FL5L48lP+ArtyXB9CRh9CVB3ql0LYZlh8UfhmciDyQr0mtTqBHoK09",
-	"hS5ShcgrnAPO8Kukzuypd+AE+qScLTWhB6cj1dRwdrkHbWRmtqVAn8BTQNfQyhQjKoE4NSE+VaNn4MPJ",
-	"YZYXNNfy1zXM9HHMU7ob5oklOsUMd3++dL/8sDFfrjx4WHn0uz/fGjdFwvHu2Qk6iqBUtPTlsWoP9MjA",
-	"nDtmK7RnlJZGY/eNiquuCtFB9J5BG84io8k96Kk3L+AK+qpfcCkPVPAeE+jDlYrTtvwb9OTxl9PHos81",
-	"eqYOx/rghRki0rVirEaoLOdiLgc/OFee2Jw5kAupJT5/WKPSDB99ckGBe/As1uTG+gYiNHxEby+KRybP",
-	"6YT0oQN9eA/98aSkMk7+Ufo0vdLaFOwBb9Ts3bE2TA9DuCOPdPMOIxrt+yxs0oNzTODZXcTXWWxzQwkU",
-	"1dqYJZTpMVH9pNeAU+SdC0VDx1o7IC/twzl0ybCFt8OscJKcGg6K5VSTOY4ryICTiOsQbQOp1bUrHPcx",
-	"cwzTiCqqtF3yQAkYJH15CFdR30zRKIrDnpZG6Ks800b6jLF1jkt0rUkiSKnSsTmwh5gOwdJ0YKioRvE7",
-	"Yuib3EN7J4/gfKwFmKXILvI3keqdJtu4UfVrBqqTOyAZIlwits0g8vTtSVj4CdpyTx7Kv8dBdKKT3bC1",
-	"CVcYztBBXJMolWXEnzwez5rjQyMli0L1Es7wa5UnJ5GI8jWBE7QRh6hhWut29efRC4SczIrnX2SGkZ9N",
-	"G5zZVcOYJYMO22CrqT6N7qcO06HOCnG7hVYts8lpq5D/Ujn90lfuBm2tpRo+1GO7trqqmRoojWFo3HL1",
-	"LaI+4ed2yQZrfsujW5FJaXJg6xSOmiZT/ZgqfZMVObQ15Zdmq3rTFzUxiwz3/Qlr39HdfWwdnIrfQyL3",
-	"iTyUr6CtZhhcG15Aj9yLHSh/kAdF6MO7SIGcy2OdXjIzLnThQ1Jy4wmmGGGLK+dH/6UJYZErPljkQnUQ",
-	"41vP1Wz/xUOK6VtRxONssvP/JoJuzikj0PkZ3sl/QhfO8MzS/H3nraof8/tTGKnjpeC5wmjvRgCegEB0",
-	"eoAQ1I3vPCA+x6GLw5E3xWPyNnx2NCYLFr38J6131kbQOmVvaPqbqLF7voz7qMmF7MSLkbQxUxU4v8AV",
-	"9BTZnZFa/Qvd3Zv0i4RrwFmrfyGPCgTeI5pzK5KpBO0AwAqJKQAHXCwF1eF91GR1pV5dSYyeQWYlCG2T",
-	"WQGfHiMffdU38aCvu+q65R5EGN0Jjrsgi7KvpfocVw1WygsedUM5nSj6OZG1f9AlBnyYiMy7zwdvpi/a",
-	"06H3VhF+O9qcDj/5PZxDG94TaGOygx508Hs1spf3M6OxQGsNn70c/OxIZxFM7NEDPTjxIFUCJZ7/gTNL",
-	"bNPWWut/AQAA//80B9xs2CUAAA==",
+	"H4sIAAAAAAAC/9xcb28bx5n/KoO5AyoDa4n64wCnvFJi1TWQOCqlAEVlgV6RY2kbcpfZXdpRBQL6U8ft",
+	"ObDOvQBXFEiMoDjcW5oSLUqWqK8w8xXukxTPzOzuzO7skhSVNPELGxQ5O/PMM8/f3/PM7uKq12h6LnHD",
+	"AC/u4qbt2w0SEp//tUbsxgO7QX7bIv4OfFEjQdV3mqHjuXgR03/QS9qn57RD37Fv6CUd0B6ifXrBjhA9",
+	"pwN6QTv0kp6wF9jCDjzxJZ/Iwq7dIHgRh8RuVPhnC/vky5bjkxpeDP0WsXBQ3SYNGxZtOO4nxN0Kt/Hi",
+	"rIXDnSY8GoS+427hdtvCnwfEv1/Lo/Fv9IT26CU7oH32J0EtO6ADtofoFR1wwk/pgHb51z36jh3lENsK",
+	"iF9xahOQ2oZHg6bnBoSz9yO7ViZftkgQwl9Vzw2Jyz/azWbdqdqwhZk/BLCPXUy+shvNOuEffd/zxSM1",
+	"WOCjpbuV8vJvP19eXcMWbpAgsLfge19Mjja92s4iavpek/jhDnqY8P0hRk6AGk4QRMxM9vLvPnmMF/G/",
+	"zSQSMiN+DWaWgYKy3IvYWYrt/0M79Irt0QHbR/SS9hDbpwM6YAe0S3vsgO3DJ3YIn+GnK9pjXysn9DXt",
+	"075CEOfXUhA4W26DuOHyE8kpuSlHMNSuhoIxGRm4pH0+Z3z2fXpKL0Aw+L8+O0JTv7u9BM/Db+9oHwU7",
+	"QUgat3DmHC1c9YkdklrF5jQ89vwGfMI1OyS3Q6dBTM8QILkivt7FxG018OI6tvmWCEhVy1X+8En8x4Zh",
+	"Mpc8rfjkiUOeCpnMbvk72qGn9JJ22HO+w0v2gp4htkd7tMu+YS/5MeyhqWgZCyVLGvfs1WtDlvyBXrIj",
+	"dpCzUMsdeSn42XM1NrVCD0Tbdlt2HYOw2dXQecI1xMCgtqqj6yrrLSkj8SLaYSYzeZt/INUQiEmEbjW0",
+	"wyArdM1WvV6Rqsa/cELSCIZp0Eo5PXE7Xtz2fXsH/gaTM/qUYAeHTppijVjBSm3CxAdd5YsN0oPP1iq/",
+	"/uzzB3dT5ijwWn6VINcL0WOv5dY4OTo346n0r8XEiUDoJm9teenTyvLv7q+urWILr5S1z58ul+8tAyVA",
+	"1dLq6v17D+SflY+XHty9f3dpbRlbGs33H6wtlx8sfVJZLpc/Kxs1MN7W7hDh45Qn47OsTY0XDDCdwG+I",
+	"XQ+3P94m1S+yDIr5llLK12yP9tlz2gdbgLgBPuemoYOm2IF0gOd0gLi17qMgtMNWgB7bTt2om3U7JG51",
+	"p9IIdNPntTbrit1zW41N4sMDwnum6arZob1pB8RCDWfL53ocxIZ3uxXWvKeuaXlBnioJ3hfYwkDucDsg",
+	"Iw05h7aXfH6XSdPzDb6mCucwunKqh2fQ9In2FW9I0pS/mdV4GX0zN7G8adWslSs0n9KvZE5dGxMJlNFt",
+	"RIsFygjHDcmWEMbsPj9bWX6ALSyNhEnTkyA1q11/T4Jc0K4O7Yr4gnZMwguhQSX0Kg3ib5FKQKqeWwsM",
+	"s34vYqIBPYVpZXhCT0BJL8CZ0re08yFyW/W6JYLYc9pBK+U4zooG9eglthIdddzwgwVsYXjQBm2V4Wua",
+	"TanjTR+Q6TgsLZSP5VE/EaOAtOp1JQZOhXMySIjjDgOzZFQOe88EPOxZJg6BSB9Nlaan58C6xZqbPaqU",
+	"ftqtcNvz8+RThhBL+eFgDs9Vf+JvTTbDzSnS+FpyLZFJeKqIjOHMh8jN6rbRPhef2PvALBNfypJrn3h2",
+	"LcsTr0lcyViT3fk/2mf79B0dINiCtCgdRYcQ7YIJGojMiR0g2mUv6Ju0xVMMbpQ0Dw2TkuxaI7Joj2XF",
+	"umT3OkqKNKBdY7YijCv6/71vEURKcrsvhIHlNubPwCa2z75BtI8E04AdA7ZPu3QALKFv6Ql7xS3wUNU1",
+	"5FbXkNihcpVepoi5q6ROqoJTBq/XoeeIvmEveLwmhABEgDMpzUyO/qRiJ9utOWDcKnXPrgU56esx22OH",
+	"9JR7N3ouvWwfXCIPXLuWEE+IXoXXZYcgogN6Rfucmne0w48IwAa2JwGeY5Hsw1GCmz3M/ErPEH1De/QU",
+	"sUP2nL1iB7Qj3EtCUF/zHkVxn6aQxsDPt0OytaMaEN92a14DW7hO7CDkPBKgAORMFd/bdFxs4afE2dqG",
+	"xFUOHyFOk0uZzh2gvqwSNQiE8aNHuTDLpyQK/dN71SKpYaieSrkaXEQk5W3irgINlEnQqhtsQwwfkFpF",
+	"Gh59j0PjgUysOZYwaJZrGKNGZ41xW6NEYcqxZXjlBBU+q0rLpufVie2mDHzhiYqRo+0p8QXxM5ZCh2kH",
+	"gH2MTXsRm4tc18R7UQ9t+L6GplAidoxMuxmaU3ICjgX20ErZQvQY0PE8JPwbaeNNUfSF0d0PCTBSoCQ4",
+	"zh49Y8/BTgtjLgOPD5Hwp1d8tT4diPyKu19wyF0eqfTZgZGKBF6s2E/tHSM/zhXogzuLUzXOYft5PDkC",
+	"oi/ZEXg+4+LFQhV6oV2vpGxHMZdE9tenp4i+ZYdsj14K12Yh2qXn9B17yRl4FFF8qCC+vYiFvUx+1Kc9",
+	"egHRC70S/pNeyK1xCLc3Zjh3ozqhiZGJaVZG5rOnbkDa2hZ23MeesUIgywHsiL1kf6H9SNJkDYO9EIHv",
+	"MTtM5LInYhEeBKYrDCCuXTHFMRwLF6Jz2kOPZJnh0YcPXTqgl+LYrmgHJuMxCe1qJ40MR3eGph7NNJMc",
+	"aGbbCULP33l0axrR1yDSh5zcdyAanLSXPJp9JIoaj6YfcnTNCSEoxStlFHkmlBgbtEr8J06VoKk1EoRo",
+	"zQ6+sNCv7XodzZXm7kAA9IT4geDf7HRpuhQZALvp4EU8P12anscWbtrhNpfymW0OQcHHLRLKdERAf/dr",
+	"eBHfI6EAqXCqTDZXKo1XH4ugufVdDa6cnZ6Li3kR/phkU4sAebUt/ZG56YX4kQSoHPJQKX5EQTK1BzbS",
+	"E4xYdtMQSVPV7a9gUQWUO5Cm+pz2oy/OZCn0Bax4pzR/ba5KsFk8/FWIasSu1R2XIPJVlRARrGpsLJVK",
+	"haznQGN7I/PNjTHmf8HGgY18A5oM7oSnDjqjIg+jcYt2RBmy1WjYUGDGoF/0hA95o5bVTtkh+AtIF7iJ",
+	"UKYeQLp0LM1sFwrlvP7Z537mlKu+8M7glc5EsvMWAD96hi0c2lvAdgnh4g0gRyrTH4dr0x8nVqcJRFXi",
+	"zaYT+YFvmTtyFNeFnwsTmOE4N8Jf0x7bh9FvaR9QvLrzhLgkCG7l8Ug1kAKi47GTFxj4teIFoQIqfSyG",
+	"W1EN/SOvtjMe1xT8CbdmsSGBx03/9mypNGtEfBbxUq2GAmL71W2d6wUw15AI3AAhjPPEdTK3ccEts9PW",
+	"Gy7aGYGeHe9omn4euryOW3PYwq15vKFSNfkJKpaNw4NwoirCkkFE1tNoXUmJvoDKtpUeMauNmDeMmNdG",
+	"LEiLGwEQOtzQLhC6pj9M/RVVyux1lAw5gZ/abaNI6NZkpawVTGDJhVIpb7FYemaUDhz+yMIIgnRTHTL/",
+	"FRWLZtReqdgJQdCnOeyF0n9M1iak1sWTqvxKGTk1ZNd9Ytd2EPnKCcLgJjuB4GgOIfEEoO2Q/YX21N6f",
+	"tKX/ITpEHlYDAt2Py2rAIt7G9RzmEIGNGhr3+TO8TjZnLvyILErrTMsU7aQjUQQ4MLgTGW8XuV9lht/I",
+	"0ZbWX7e+a+wzy5rM6/abbUzq+XnjjKBUtlZhkUDobTOLGDKC27Ozt2dLa7Nzi6XSYqn0e6z3PKmtThls",
+	"XpjdqO9HtPu0rWRVu+5USdGiC4vzxkV9UrzsHQMsnqZGdh0BO/M9QIG1JE/GAgnTXW4GfPAGagGSqI0R",
+	"jCtXyjfsBde9I0hP2TOuUJcysT1WdBJgBI4o7LEjegIZ7y/BGEfFc9Xqpk3T39i+tBOcC6ak3Gh0Vsqj",
+	"mxWOaowcpH7KR08Qo15LnMeNIoeI4vUivtJPE/ElVXlpbUq35xbAxM0vLN754Pc3FhPKWvENxlwjRk2g",
+	"zPsyHR2wIy7HfRSR834o7mtRsGYHMkJYKYsM+1zuE03xqmKPXkgoVLSLXwpQdSDBPt6TzI5uja7Mke8Z",
+	"WZ+jstAkKg3OTMsTrum0tHluPLMcqVQdLf+vtxCAY7bu/AQ5oU+adbtKapXNnSg6GTNN1JPAO4YkcE4b",
+	"8cFPlwRqmyvo4xrwFopjOsj6U2Nb3YTJpSaIPtbpHCk+ep1fYhHNEbwwI68aDH4pZlU04+aXBrNmd8wc",
+	"ValdwifFYn8n1qCnYICjsgxP7q54i8072kNxW/cTu97Ky3fjQUm+W7Vd6D+PjDPyXCRogECNs8L1Po7U",
+	"LEsXQLsnwmGyQ3ol2wxN7SlFpKV6zxPqXA+JajCSUsgrMbHaI8dFUCiLCA2XouxmcTft8vIPTRR10+Ka",
+	"V+Ut2ITWT682+stikhPwXv/IqqLQQ+G2E0hO3+Bto+9oh7f//DnRu5OoTS06oite5u2CXBdURdlRNnzI",
+	"DpVQwzlPgs7hZx4w5Fkt2ah0AjTCED5MgBE98Tl9W64gxOBAjQr8p3jxin6bAdR5TfFKlix7vDHqGYJK",
+	"BT2DZaGoCQVlts+e8TgoonjAsXZemOT1xDMktgTFdzAOF7RPj6PgiJ5ZD122r+L6cb/cM15z6fOLX0cx",
+	"NTGmD8NEENqRDuCc9kWRMoOrlMX+f55Vjf+OijzXKrFNVP0qoOo72lPqT1nMTTmxeJS8EMLV6SIqc+sV",
+	"8SkQRaewBAM7CmZS/Q5muX2d9Hqg9ce+17BQ6N1C/Eogr6tx7elFvfEKIKG1ReSUyzM9BNZDN9UxcMtK",
+	"Nc/LFgqlL4R3hF6Bwnb5t8LA9JHJjlhIdOXnPMNVRWvxn0ZqoMaf44b7nMOmHXaU7kLsWFqHjNRZaGx8",
+	"JVWvCz6cHQHXph+6cYsF4p0Me3JhuBzUjeyE0d1Dl45mo8AUaPtL/aahqjl6DPIaLGlNHSOgo9o9g1Gv",
+	"CJunAhnTZhnlImfeZKE3/lQT47Opq4fru5OlI6mmxtksQKG1N+FNu/oFcWs492bLnYVSCXYp7zGuG1rV",
+	"Zq1sApPp35rNXTfbTjWfqpOpjUn4I28TtzdGNqyZ25QmcFQU/MF6wv9RG0HGBP0ialJ/Ly5EZXshRt19",
+	"Tj2Gm8WcRjvRnaahp+IUhGcBgZixa7VidAU6W5dqtUkQlbgHel1rKBWlGEXUZnVRW+Ili7ZV/JBRPnVp",
+	"b9o7QrRHFtu1OEO44cJ5KJvE/9UsiQxAETwR0ToCo0bJ8XXN0Fx3R1Ht61dn9TvMSTKVOO0fr0ab3t11",
+	"67VaGgM3K/gVCn4L4yB+VcgF7aOphIFwwWJG9FJx/X8nwpWCSEQNNuEENYuQ9MCrhiETagpEOM6OAJrp",
+	"0HNuwro87LsQF1sgm3mW3QU3XnodeUoCJqlL1SJ55Ckjxy2SfLSLpNAHt6YfuvSvatvXRdQInbRoD3ig",
+	"mb1cY+n2tiPfrnGk3FyiHZlsalvM2ddDl+/gDHEZ0Df5IeJVPthmBvGQkeeBiFvzbkQl9E0j6FZkrzSE",
+	"jB1FP/McUfTpATHiig695Js6F68HMcWVkc2/m4jBBKbfHHQkN0Z48WhjiBEarW9KndZ4zZjDBCeanPZl",
+	"iyFXPvVs4j55YaAGIkbvsn32Uj/RQ/Ua0xD6Ct8jkWz0J0HrzRd4xHFk4tj1XVPlfz6/8l9UMDHMxS9i",
+	"jzvXXL5TG8fNG247mez79+xAXGVDWSmSL9uRVwFyYTH27P2MYL/NV6mR7H4e2yRE2AdTz9+6AcZaXK1Q",
+	"rHiRK5MwSV5/EYy/R8Js2mxiYDJkRn+p1+RJ6M8mGBw/PE5rCX3D/pMDLgepU34/Rf91fF+oP1b4liO0",
+	"PMsHqRV1tiLZhWttwb145LgirL7x7cdAUX7UKu9GppF3pFabMV4tlX43Qs6bpca8lz/sFVHGxrErHvfC",
+	"/YeV8q/inN+U7v8iVOz16MXQYlVbKf+KvUiC+4Ii60g1ukgduV5p6hiQ8H6wFF+CzUdK+KOryugJ4mbF",
+	"oj+26wEZXeJv6LZxrhAX3bb9EeLUlryWnGVIYWqR5/kKGBetNOwFcSPCHd8r+fir+PWBeXL6fuvuP7TC",
+	"pNBf9ieoNdFjLaeOr3UVvFk0o6nt+LvdqJYgnGrbir8Qg5UvtLKw8n300r/4C1mMa2+0/zkARxouZupV",
+	"AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,229 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func envFrom(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+// testNames — проверки имён вместо тех, что main берёт из service и storage
+var testNames = Names{
+	Strategy:  oneOf("random", "least_loaded", "round_robin"),
+	Isolation: oneOf("", "serializable"),
+}
+
+func oneOf(known ...string) func(string) error {
+	return func(name string) error {
+		for _, k := range known {
+			if k == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown name %q", name)
+	}
+}
+
+func writeFile(t *testing.T, name, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Файл переопределяет значения по умолчанию, окружение — файл, флаги — окружение
+func TestLoadPrecedence(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+http:
+  addr: ":9000"
+  read_timeout: 3s
+db:
+  host: file-host
+  port: 6000
+reviewer:
+  teams:
+    backend: round_robin
+`)
+	env := envFrom(map[string]string{
+		"CONFIG_FILE": path,
+		"DB_HOST":     "env-host",
+		"DB_NAME":     "",
+	})
+
+	inv, err := Load([]string{"--db-host", "flag-host", "migrate", "up"}, env, testNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := inv.Config
+
+	if c.HTTP.Addr != ":9000" || c.HTTP.ReadTimeout != 3*time.Second {
+		t.Errorf("file values: got addr %q, read timeout %s", c.HTTP.Addr, c.HTTP.ReadTimeout)
+	}
+	if c.HTTP.WriteTimeout != Default().HTTP.WriteTimeout {
+		t.Errorf("default kept: got write timeout %s", c.HTTP.WriteTimeout)
+	}
+	if c.DB.Host != "flag-host" {
+		t.Errorf("flag over env: got host %q", c.DB.Host)
+	}
+	if c.DB.Port != 6000 {
+		t.Errorf("file over default: got port %d", c.DB.Port)
+	}
+	if c.DB.Name != Default().DB.Name {
+		t.Errorf("empty env is ignored: got name %q", c.DB.Name)
+	}
+	if c.Reviewer.Teams["backend"] != "round_robin" {
+		t.Errorf("team strategies: got %v", c.Reviewer.Teams)
+	}
+	if strings.Join(inv.Args, " ") != "migrate up" {
+		t.Errorf("args: got %v", inv.Args)
+	}
+}
+
+func TestLoadTOML(t *testing.T) {
+	path := writeFile(t, "config.toml", `
+storage = "sqlite"
+
+[sqlite]
+path = "/var/lib/reviewer.db"
+
+[http]
+write_timeout = "2s"
+`)
+
+	inv, err := Load([]string{"--config", path}, envFrom(nil), testNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.Config.Storage != StorageSQLite || inv.Config.SQLite.Path != "/var/lib/reviewer.db" {
+		t.Errorf("got storage %q, path %q", inv.Config.Storage, inv.Config.SQLite.Path)
+	}
+	if inv.Config.HTTP.WriteTimeout != 2*time.Second {
+		t.Errorf("got write timeout %s", inv.Config.HTTP.WriteTimeout)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	path := writeFile(t, "config.yaml", "http:\n  adress: \":80\"\n")
+
+	_, err := Load([]string{"--config", path}, envFrom(nil), testNames)
+	if err == nil || !strings.Contains(err.Error(), "adress") {
+		t.Fatalf("got %v, want an error naming the unknown key", err)
+	}
+}
+
+// Validate сообщает обо всех ошибках сразу, с именем поля
+func TestValidateReportsAllErrors(t *testing.T) {
+	c := Default()
+	c.DB.Port = 0
+	c.DB.MaxIdleConns = 50
+	c.DB.TxIsolation = "snapshot"
+	c.Reviewer.Strategy = "fastest"
+	c.Reviewer.Teams = map[string]string{"backend": "round_robin", "payments": "slowest"}
+	c.Log.Level = "loud"
+	c.Log.Format = "xml"
+
+	err := c.Validate(testNames)
+	if err == nil {
+		t.Fatal("want validation error")
+	}
+	for _, field := range []string{"db.port", "db.max_idle_conns", "db.tx_isolation", "reviewer.strategy", "reviewer.teams.payments", "log.level", "log.format"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("error does not mention %s:\n%v", field, err)
+		}
+	}
+	if strings.Contains(err.Error(), "reviewer.teams.backend") {
+		t.Errorf("valid team strategy reported:\n%v", err)
+	}
+
+	// Без проверок имён остаются только ошибки самой конфигурации
+	err = c.Validate(Names{})
+	if err == nil || strings.Contains(err.Error(), "reviewer.strategy") || strings.Contains(err.Error(), "db.tx_isolation") {
+		t.Fatalf("got %v", err)
+	}
+}
+
+// Булев флаг задаётся без значения; окружение принимает true/false
+func TestLoadBoolFlag(t *testing.T) {
+	cases := []struct {
+		args []string
+		env  map[string]string
+		want bool
+	}{
+		{nil, nil, false},
+		{[]string{"--validate-responses"}, nil, true},
+		{[]string{"--validate-responses=false"}, map[string]string{"VALIDATE_RESPONSES": "true"}, false},
+		{nil, map[string]string{"VALIDATE_RESPONSES": "true"}, true},
+		{[]string{"--validate-responses", "migrate"}, nil, true},
+	}
+	for _, c := range cases {
+		inv, err := Load(c.args, envFrom(c.env), testNames)
+		if err != nil {
+			t.Fatalf("%v: %v", c.args, err)
+		}
+		if inv.Config.HTTP.ValidateResponses != c.want {
+			t.Errorf("%v %v: got %v, want %v", c.args, c.env, inv.Config.HTTP.ValidateResponses, c.want)
+		}
+	}
+
+	if _, err := Load([]string{"--validate-responses=maybe"}, envFrom(nil), testNames); err == nil {
+		t.Error("want error for a non-boolean flag value")
+	}
+}
+
+func TestParseTeamStrategies(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"", map[string]string{}, false},
+		{"backend=least_loaded", map[string]string{"backend": "least_loaded"}, false},
+		{" backend = least_loaded ,payments=round_robin,, ", map[string]string{"backend": "least_loaded", "payments": "round_robin"}, false},
+		{"backend", nil, true},
+		{"backend=random,payments", nil, true},
+	}
+
+	for _, c := range cases {
+		got, err := parseTeamStrategies(c.in)
+		if (err != nil) != c.wantErr {
+			t.Fatalf("%q: error %v, want error %v", c.in, err, c.wantErr)
+		}
+		if !c.wantErr && !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%q: got %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	c := Default()
+	c.DB.Password = "s3cret"
+
+	var out strings.Builder
+	if err := c.Print(&out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "s3cret") {
+		t.Fatalf("password leaked:\n%s", out.String())
+	}
+
+	for dsn, want := range map[string]string{
+		"postgres://app:s3cret@db:5432/reviewer": "postgres://app:xxxxx@db:5432/reviewer",
+		"host=db password='s3 cret' user=app":    "host=db password=*** user=app",
+	} {
+		if got := redactDSN(dsn); got != want {
+			t.Errorf("redactDSN(%q) = %q, want %q", dsn, got, want)
+		}
+	}
+}
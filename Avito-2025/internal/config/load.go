@@ -0,0 +1,240 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Invocation — разобранный запуск: итоговые настройки и остаток командной строки
+type Invocation struct {
+	Config      Config
+	PrintConfig bool
+	Args        []string // подкоманда и её аргументы, например migrate up
+}
+
+// setting — одна настройка, которую можно задать переменной окружения и флагом
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   setter
+}
+
+// setter — разбор значения настройки; isBool — флаг без значения,
+// --validate-responses вместо --validate-responses=true
+type setter struct {
+	apply  func(c *Config, v string) error
+	isBool bool
+}
+
+var settings = []setting{
+	{"HTTP_ADDR", "http-addr", "listen address", str(func(c *Config) *string { return &c.HTTP.Addr })},
+	{"HTTP_READ_TIMEOUT", "http-read-timeout", "request read timeout", duration(func(c *Config) *time.Duration { return &c.HTTP.ReadTimeout })},
+	{"HTTP_WRITE_TIMEOUT", "http-write-timeout", "response write timeout", duration(func(c *Config) *time.Duration { return &c.HTTP.WriteTimeout })},
+	{"HTTP_IDLE_TIMEOUT", "http-idle-timeout", "keep-alive idle timeout", duration(func(c *Config) *time.Duration { return &c.HTTP.IdleTimeout })},
+	{"HTTP_SHUTDOWN_TIMEOUT", "http-shutdown-timeout", "how long to drain in-flight requests on SIGINT/SIGTERM", duration(func(c *Config) *time.Duration { return &c.HTTP.ShutdownTimeout })},
+	{"TLS_CERT_FILE", "tls-cert-file", "TLS certificate file", str(func(c *Config) *string { return &c.HTTP.TLS.CertFile })},
+	{"TLS_KEY_FILE", "tls-key-file", "TLS private key file", str(func(c *Config) *string { return &c.HTTP.TLS.KeyFile })},
+	{"VALIDATE_RESPONSES", "validate-responses", "validate responses against openapi.yml", boolean(func(c *Config) *bool { return &c.HTTP.ValidateResponses })},
+
+	{"STORAGE", "storage", "storage backend: postgres, sqlite or memory", str(func(c *Config) *string { return &c.Storage })},
+	{"DATABASE_URL", "db-dsn", "Postgres DSN, overrides db-host and the rest", str(func(c *Config) *string { return &c.DB.DSN })},
+	{"DB_HOST", "db-host", "Postgres host", str(func(c *Config) *string { return &c.DB.Host })},
+	{"DB_PORT", "db-port", "Postgres port", integer(func(c *Config) *int { return &c.DB.Port })},
+	{"DB_USER", "db-user", "Postgres user", str(func(c *Config) *string { return &c.DB.User })},
+	{"DB_PASSWORD", "db-password", "Postgres password", str(func(c *Config) *string { return &c.DB.Password })},
+	{"DB_NAME", "db-name", "Postgres database", str(func(c *Config) *string { return &c.DB.Name })},
+	{"DB_SSLMODE", "db-sslmode", "Postgres sslmode", str(func(c *Config) *string { return &c.DB.SSLMode })},
+	{"DB_MAX_OPEN_CONNS", "db-max-open-conns", "max open connections, 0 = unlimited", integer(func(c *Config) *int { return &c.DB.MaxOpenConns })},
+	{"DB_MAX_IDLE_CONNS", "db-max-idle-conns", "max idle connections", integer(func(c *Config) *int { return &c.DB.MaxIdleConns })},
+	{"DB_CONN_MAX_LIFETIME", "db-conn-max-lifetime", "max connection lifetime, 0 = unlimited", duration(func(c *Config) *time.Duration { return &c.DB.ConnMaxLifetime })},
+	{"DB_TX_ISOLATION", "db-tx-isolation", "read_committed, repeatable_read or serializable", str(func(c *Config) *string { return &c.DB.TxIsolation })},
+	{"DB_TX_RETRIES", "db-tx-retries", "retries after a serialization failure", integer(func(c *Config) *int { return &c.DB.TxRetries })},
+	{"DB_PATH", "db-path", "SQLite database file", str(func(c *Config) *string { return &c.SQLite.Path })},
+
+	{"REVIEWER_STRATEGY", "reviewer-strategy", "default reviewer selection strategy", str(func(c *Config) *string { return &c.Reviewer.Strategy })},
+	{"REVIEWER_STRATEGY_TEAMS", "reviewer-strategy-teams", `per-team strategies: "backend=least_loaded,payments=round_robin"`, setter{apply: teamStrategies}},
+
+	{"HEALTH_TIMEOUT", "health-timeout", "timeout of readiness checks, 0 = none", duration(func(c *Config) *time.Duration { return &c.Health.Timeout })},
+
+	{"LOG_LEVEL", "log-level", "debug, info, warn or error", str(func(c *Config) *string { return &c.Log.Level })},
+	{"LOG_FORMAT", "log-format", "text or json", str(func(c *Config) *string { return &c.Log.Format })},
+}
+
+// Load — собрать настройки из всех источников и проверить их.
+// Файл задаётся флагом --config или переменной CONFIG_FILE; формат — по расширению.
+func Load(args []string, lookupEnv func(string) (string, bool), names Names) (*Invocation, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	configPath := fs.String("config", "", "config file, .yaml/.yml or .toml (CONFIG_FILE)")
+	printConfig := fs.Bool("print-config", false, "print the effective config with secrets redacted and exit")
+
+	byFlag := make(map[string]setting, len(settings))
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (%s)", s.usage, s.env)
+		if s.set.isBool {
+			fs.Bool(s.flag, false, usage)
+		} else {
+			fs.String(s.flag, "", usage)
+		}
+		byFlag[s.flag] = s
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+
+	path := *configPath
+	if path == "" {
+		path, _ = lookupEnv("CONFIG_FILE")
+	}
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return nil, fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+
+	// Пустая переменная считается незаданной
+	for _, s := range settings {
+		if v, ok := lookupEnv(s.env); ok && v != "" {
+			if err := s.set.apply(&cfg, v); err != nil {
+				return nil, fmt.Errorf("env %s: %w", s.env, err)
+			}
+		}
+	}
+
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		s, ok := byFlag[f.Name]
+		if !ok || flagErr != nil {
+			return
+		}
+		if err := s.set.apply(&cfg, f.Value.String()); err != nil {
+			flagErr = fmt.Errorf("flag --%s: %w", f.Name, err)
+		}
+	})
+	if flagErr != nil {
+		return nil, flagErr
+	}
+
+	if err := cfg.Validate(names); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	return &Invocation{Config: cfg, PrintConfig: *printConfig, Args: fs.Args()}, nil
+}
+
+// loadFile — наложить файл на cfg; неизвестные ключи — ошибка, чтобы опечатки не терялись
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		return nil
+	case ".toml":
+		md, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return err
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			keys := make([]string, len(undecoded))
+			for i, k := range undecoded {
+				keys[i] = k.String()
+			}
+			return fmt.Errorf("unknown keys: %s", strings.Join(keys, ", "))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q, want .yaml, .yml or .toml", filepath.Ext(path))
+	}
+}
+
+func str(field func(c *Config) *string) setter {
+	return setter{apply: func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}}
+}
+
+func integer(field func(c *Config) *int) setter {
+	return setter{apply: func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", v)
+		}
+		*field(c) = n
+		return nil
+	}}
+}
+
+func duration(field func(c *Config) *time.Duration) setter {
+	return setter{apply: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration like 5s or 1m30s", v)
+		}
+		*field(c) = d
+		return nil
+	}}
+}
+
+func boolean(field func(c *Config) *bool) setter {
+	return setter{isBool: true, apply: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", v)
+		}
+		*field(c) = b
+		return nil
+	}}
+}
+
+// teamStrategies — переопределения из строки заменяют заданные в файле целиком
+func teamStrategies(c *Config, v string) error {
+	teams, err := parseTeamStrategies(v)
+	if err != nil {
+		return err
+	}
+	c.Reviewer.Teams = teams
+	return nil
+}
+
+// parseTeamStrategies — разобрать строку вида "backend=least_loaded,payments=round_robin";
+// имена стратегий проверяет Validate
+func parseTeamStrategies(overrides string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, pair := range strings.Split(overrides, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		team, name, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid team strategy %q, want team=strategy", pair)
+		}
+		result[strings.TrimSpace(team)] = strings.TrimSpace(name)
+	}
+
+	return result, nil
+}
@@ -2,6 +2,12 @@ package domain
 
 import "time"
 
+// Статусы PR
+const (
+	PRStatusOpen   = "OPEN"
+	PRStatusMerged = "MERGED"
+)
+
 // Team представляет команду
 type Team struct {
 	ID        int       `db:"id"`
@@ -11,27 +17,83 @@ type Team struct {
 
 // User представляет пользователя
 type User struct {
-	ID        int       `db:"id"`
+	ID        string    `db:"user_id"`
 	Username  string    `db:"username"`
-	TeamID    int       `db:"team_id"`
+	TeamName  string    `db:"team_name"`
 	IsActive  bool      `db:"is_active"`
 	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
 }
 
 // PullRequest представляет PR
 type PullRequest struct {
-	ID        int       `db:"id"`
-	Title     string    `db:"title"`
-	AuthorID  int       `db:"author_id"`
-	Status    string    `db:"status"` // например, OPEN, MERGED
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        string     `db:"pull_request_id"`
+	Name      string     `db:"name"`
+	AuthorID  string     `db:"author_id"`
+	Status    string     `db:"status"` // OPEN или MERGED
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	MergedAt  *time.Time `db:"merged_at"` // nil, пока PR не смержен
 }
 
 // PRReviewer — ревьювер для PR
 type PRReviewer struct {
 	ID         int       `db:"id"`
-	PRID       int       `db:"pr_id"`
-	ReviewerID int       `db:"reviewer_id"`
+	PRID       string    `db:"pr_id"`
+	ReviewerID string    `db:"reviewer_id"`
 	AssignedAt time.Time `db:"assigned_at"`
 }
+
+// StatsFilter — фильтр статистики назначений; пустые поля не ограничивают выборку
+type StatsFilter struct {
+	TeamName string
+	From     *time.Time
+	To       *time.Time
+}
+
+// UserAssignmentStats — нагрузка ревьювера
+type UserAssignmentStats struct {
+	UserID           string
+	Username         string
+	TeamName         string
+	OpenReviews      int // назначен сейчас на OPEN PR; от периода не зависит
+	TotalAssignments int // назначения из журнала, включая полученные переназначением и потом снятые
+	MergedReviewed   int // смерженные PR, где он остался ревьювером
+	ReassignedAway   int // сколько раз ревью с него сняли
+}
+
+// PRAssignmentStats — история назначений по PR
+type PRAssignmentStats struct {
+	PRID          string
+	Name          string
+	TeamName      string // команда автора
+	Status        string
+	Reassignments int
+	TimeToMerge   *time.Duration // nil, пока PR не смержен
+}
+
+// Типы событий назначения ревьюверов
+const (
+	EventAssigned   = "assigned"
+	EventUnassigned = "unassigned"
+	EventReassigned = "reassigned"
+)
+
+// Причины изменения состава ревьюверов
+const (
+	ReasonAuto         = "auto"         // автоматическое назначение при создании PR
+	ReasonManual       = "manual"       // явный запрос через API
+	ReasonDeactivation = "deactivation" // ревьювер деактивирован
+)
+
+// AssignmentEvent — запись журнала назначений ревьюверов
+type AssignmentEvent struct {
+	ID            int64     `db:"id"`
+	PRID          string    `db:"pr_id"`
+	Type          string    `db:"event_type"`
+	OldReviewerID string    `db:"old_reviewer_id"` // пусто для assigned
+	NewReviewerID string    `db:"new_reviewer_id"` // пусто для unassigned
+	Actor         string    `db:"actor"`
+	Reason        string    `db:"reason"`
+	CreatedAt     time.Time `db:"created_at"`
+}
@@ -0,0 +1,82 @@
+// Package health — проверки готовности сервиса для /readyz и /health.
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrShuttingDown — сервис останавливается и новых запросов не ждёт
+var ErrShuttingDown = errors.New("service is shutting down")
+
+// Check — одна проверка зависимости, например доступность БД
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Result — итог проверки
+type Result struct {
+	Name    string
+	Err     error
+	Latency time.Duration
+}
+
+// Checker — набор проверок с общим таймаутом и флагом остановки
+type Checker struct {
+	checks       []Check
+	timeout      time.Duration
+	shuttingDown atomic.Bool
+}
+
+// New — проверки выполняются с таймаутом timeout каждая; 0 — без таймаута
+func New(timeout time.Duration, checks ...Check) *Checker {
+	c := &Checker{timeout: timeout}
+	c.checks = append(c.checks, checks...)
+	c.checks = append(c.checks, Check{Name: "shutdown", Run: func(context.Context) error {
+		if c.shuttingDown.Load() {
+			return ErrShuttingDown
+		}
+		return nil
+	}})
+	return c
+}
+
+// SetShuttingDown — с этого момента сервис не готов
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Run — выполнить проверки параллельно; результаты в порядке регистрации.
+// ok — все проверки пройдены.
+func (c *Checker) Run(ctx context.Context) (results []Result, ok bool) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	results = make([]Result, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			err := check.Run(ctx)
+			results[i] = Result{Name: check.Name, Err: err, Latency: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+
+	ok = true
+	for _, r := range results {
+		if r.Err != nil {
+			ok = false
+		}
+	}
+	return results, ok
+}
@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	dbDown := errors.New("connection refused")
+	c := New(50*time.Millisecond,
+		Check{Name: "database", Run: func(context.Context) error { return nil }},
+		Check{Name: "slow", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		Check{Name: "cache", Run: func(context.Context) error { return dbDown }},
+	)
+
+	results, ok := c.Run(context.Background())
+	if ok {
+		t.Fatal("want not ok")
+	}
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = r.Name
+	}
+	if got := len(names); got != 4 || names[0] != "database" || names[3] != "shutdown" {
+		t.Fatalf("results out of order: %v", names)
+	}
+	if results[0].Err != nil || results[3].Err != nil {
+		t.Errorf("passing checks failed: %v, %v", results[0].Err, results[3].Err)
+	}
+	if !errors.Is(results[1].Err, context.DeadlineExceeded) {
+		t.Errorf("slow check: got %v, want deadline exceeded", results[1].Err)
+	}
+	if !errors.Is(results[2].Err, dbDown) {
+		t.Errorf("failing check: got %v", results[2].Err)
+	}
+}
+
+func TestShuttingDown(t *testing.T) {
+	c := New(0)
+	if _, ok := c.Run(context.Background()); !ok {
+		t.Fatal("want ready before shutdown")
+	}
+
+	c.SetShuttingDown()
+	results, ok := c.Run(context.Background())
+	if ok || !errors.Is(results[0].Err, ErrShuttingDown) {
+		t.Fatalf("got ok=%v %v, want ErrShuttingDown", ok, results[0].Err)
+	}
+}
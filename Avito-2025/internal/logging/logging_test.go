@@ -0,0 +1,53 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRequestIDFromContext(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(&buf, "info", FormatJSON).With("component", "test")
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	log.InfoContext(ctx, "PR создан", "pr_id", "pr-1")
+	log.Info("без запроса")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines:\n%s", len(lines), buf.String())
+	}
+
+	var first, second map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatal(err)
+	}
+	if first["request_id"] != "req-1" || first["pr_id"] != "pr-1" || first["component"] != "test" {
+		t.Errorf("first record: %v", first)
+	}
+	if _, ok := second["request_id"]; ok {
+		t.Errorf("record without request: %v", second)
+	}
+}
+
+func TestLevelAndFormat(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(&buf, "warn", FormatText)
+
+	log.Info("скрыто")
+	log.Warn("видно", "attempt", 2)
+
+	out := buf.String()
+	if strings.Contains(out, "скрыто") {
+		t.Errorf("info record passed the warn level:\n%s", out)
+	}
+	if !strings.Contains(out, "level=WARN") || !strings.Contains(out, "attempt=2") {
+		t.Errorf("want a text record:\n%s", out)
+	}
+}
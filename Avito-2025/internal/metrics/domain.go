@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"avito-2025/internal/domain"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Domain — счётчики назначения ревьюверов. Метка reason — domain.Reason*.
+type Domain struct {
+	prsCreated         prometheus.Counter
+	prsMerged          prometheus.Counter
+	reviewersAssigned  *prometheus.CounterVec
+	reassignments      *prometheus.CounterVec
+	noCandidate        *prometheus.CounterVec
+	reviewersPerCreate prometheus.Histogram
+}
+
+func newDomain() *Domain {
+	return &Domain{
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "reviewer_prs_created_total",
+			Help: "Pull requests created.",
+		}),
+		prsMerged: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "reviewer_prs_merged_total",
+			Help: "Pull requests moved from OPEN to MERGED.",
+		}),
+		reviewersAssigned: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reviewer_reviewers_assigned_total",
+			Help: "Reviewers assigned to pull requests, by reason.",
+		}, []string{"reason"}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reviewer_reassignments_total",
+			Help: "Reviewers replaced by a teammate, by reason.",
+		}, []string{"reason"}),
+		noCandidate: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reviewer_no_candidate_total",
+			Help: "Replacements that found no active candidate, by reason.",
+		}, []string{"reason"}),
+		reviewersPerCreate: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "reviewer_pr_assigned_reviewers",
+			Help:    "Reviewers assigned when a pull request is created (0, 1 or 2).",
+			Buckets: []float64{0, 1, 2},
+		}),
+	}
+}
+
+func (d *Domain) register(reg prometheus.Registerer) {
+	reg.MustRegister(d.prsCreated, d.prsMerged, d.reviewersAssigned, d.reassignments, d.noCandidate, d.reviewersPerCreate)
+}
+
+// PRCreated — PR создан с reviewers ревьюверами (назначение auto)
+func (d *Domain) PRCreated(reviewers int) {
+	d.prsCreated.Inc()
+	d.reviewersPerCreate.Observe(float64(reviewers))
+	d.reviewersAssigned.WithLabelValues(domain.ReasonAuto).Add(float64(reviewers))
+}
+
+// PRMerged — PR переведён в MERGED
+func (d *Domain) PRMerged() {
+	d.prsMerged.Inc()
+}
+
+// ReviewerAssigned — ревьювер назначен на существующий PR
+func (d *Domain) ReviewerAssigned(reason string) {
+	d.reviewersAssigned.WithLabelValues(reason).Inc()
+}
+
+// ReviewerReassigned — ревьювер заменён коллегой
+func (d *Domain) ReviewerReassigned(reason string) {
+	d.reassignments.WithLabelValues(reason).Inc()
+}
+
+// NoCandidate — замены не нашлось
+func (d *Domain) NoCandidate(reason string) {
+	d.noCandidate.WithLabelValues(reason).Inc()
+}
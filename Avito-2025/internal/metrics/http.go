@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"avito-2025/internal/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+// otherOperation — запросы вне API: неизвестные пути, /metrics
+const otherOperation = "other"
+
+// Middleware — число и задержка запросов по операциям api.ServerInterface.
+// routes — e.Routes() после api.RegisterHandlers: из них берутся имена операций.
+// Ставится до валидатора, чтобы учитывать и его ответы.
+func (m *Metrics) Middleware(routes []*echo.Route) echo.MiddlewareFunc {
+	ops := operations(routes)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			// Ошибку обрабатываем здесь, чтобы знать итоговый статус ответа
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			op, ok := ops[c.Request().Method+" "+c.Path()]
+			if !ok {
+				op = otherOperation
+			}
+			code := strconv.Itoa(c.Response().Status)
+			m.httpRequests.WithLabelValues(op, code).Inc()
+			m.httpDuration.WithLabelValues(op, code).Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
+
+// operations — "METHOD /path" -> имя метода api.ServerInterface, например PostTeamAdd.
+// Маршрут попадает в таблицу, только если в интерфейсе есть метод с таким именем,
+// поэтому маршруты вне спецификации остаются "other".
+func operations(routes []*echo.Route) map[string]string {
+	iface := reflect.TypeOf((*api.ServerInterface)(nil)).Elem()
+
+	ops := make(map[string]string, len(routes))
+	for _, r := range routes {
+		name := operationName(r.Method, r.Path)
+		if _, ok := iface.MethodByName(name); ok {
+			ops[r.Method+" "+r.Path] = name
+		}
+	}
+	return ops
+}
+
+// operationName — имя, которое oapi-codegen даёт операции без operationId:
+// метод и сегменты пути с заглавной буквы, POST /team/add -> PostTeamAdd
+func operationName(method, path string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(strings.ToLower(method)+path, "/") {
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
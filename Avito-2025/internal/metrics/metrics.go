@@ -0,0 +1,61 @@
+// Package metrics — метрики Prometheus: HTTP-запросы по операциям API,
+// пул соединений с БД и события предметной области.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// latencyBuckets — границы гистограммы задержки; 0.3 с — SLI по задержке
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .2, .3, .5, 1, 2.5, 5}
+
+// Metrics — собственный реестр сервиса, без глобального prometheus.DefaultRegisterer
+type Metrics struct {
+	reg *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	// Domain — счётчики для сервисов, реализует service.Metrics
+	Domain *Domain
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		reg: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests by API operation and status code.",
+		}, []string{"operation", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by API operation and status code.",
+			Buckets: latencyBuckets,
+		}, []string{"operation", "code"}),
+		Domain: newDomain(),
+	}
+
+	m.reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+	)
+	m.Domain.register(m.reg)
+	return m
+}
+
+// RegisterDB — метрики пула соединений (sql.DBStats) с меткой db_name
+func (m *Metrics) RegisterDB(db *sql.DB, name string) {
+	m.reg.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler — /metrics в текстовом формате Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.reg, promhttp.HandlerOpts{Registry: m.reg})
+}
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// stubServer — сгенерированные маршруты с двумя реализованными операциями;
+// остальные методы не вызываются
+type stubServer struct {
+	api.ServerInterface
+}
+
+func (stubServer) PostTeamAdd(c echo.Context) error {
+	return c.NoContent(http.StatusCreated)
+}
+
+func (stubServer) GetTeamGet(echo.Context, api.GetTeamGetParams) error {
+	return echo.NewHTTPError(http.StatusNotFound, "team not found")
+}
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("/metrics: got %d", rec.Code)
+	}
+	return rec.Body.String()
+}
+
+func assertLines(t *testing.T, body string, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if !strings.Contains(body, "\n"+line+"\n") {
+			t.Errorf("missing %q", line)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	m := New()
+
+	e := echo.New()
+	api.RegisterHandlers(e, stubServer{})
+	e.GET("/metrics", echo.WrapHandler(m.Handler()))
+	e.Use(m.Middleware(e.Routes()))
+
+	for _, r := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/team/add", nil),
+		httptest.NewRequest(http.MethodPost, "/team/add", nil),
+		httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil),
+		httptest.NewRequest(http.MethodGet, "/nope", nil),
+		httptest.NewRequest(http.MethodGet, "/metrics", nil),
+	} {
+		e.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	body := scrape(t, m)
+	assertLines(t, body,
+		`http_requests_total{code="201",operation="PostTeamAdd"} 2`,
+		`http_requests_total{code="404",operation="GetTeamGet"} 1`,
+		`http_requests_total{code="404",operation="other"} 1`,
+		`http_requests_total{code="200",operation="other"} 1`,
+		`http_request_duration_seconds_count{code="201",operation="PostTeamAdd"} 2`,
+	)
+	if !strings.Contains(body, `http_request_duration_seconds_bucket{code="201",operation="PostTeamAdd",le="0.3"}`) {
+		t.Error("latency histogram has no 0.3s bucket")
+	}
+}
+
+// У каждой операции api.ServerInterface есть маршрут, и наоборот
+func TestOperationsCoverServerInterface(t *testing.T) {
+	e := echo.New()
+	api.RegisterHandlers(e, stubServer{})
+	ops := operations(e.Routes())
+
+	iface := reflect.TypeOf((*api.ServerInterface)(nil)).Elem()
+	if len(ops) != iface.NumMethod() {
+		t.Errorf("got %d operations, want %d", len(ops), iface.NumMethod())
+	}
+	names := make(map[string]bool, len(ops))
+	for _, name := range ops {
+		names[name] = true
+	}
+	for i := 0; i < iface.NumMethod(); i++ {
+		if name := iface.Method(i).Name; !names[name] {
+			t.Errorf("no route for %s", name)
+		}
+	}
+
+	if got := ops["GET /users/getReview"]; got != "GetUsersGetReview" {
+		t.Errorf("GET /users/getReview: got %q", got)
+	}
+}
+
+func TestMiddlewareReportsHandledStatus(t *testing.T) {
+	m := New()
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		_ = c.NoContent(http.StatusConflict)
+	}
+	e.Use(m.Middleware(e.Routes()))
+	e.GET("/conflict", func(echo.Context) error { return errors.New("conflict") })
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/conflict", nil))
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("got %d, want 409 from the error handler", rec.Code)
+	}
+	assertLines(t, scrape(t, m), `http_requests_total{code="409",operation="other"} 1`)
+}
+
+func TestDomain(t *testing.T) {
+	m := New()
+	d := m.Domain
+
+	d.PRCreated(2)
+	d.PRCreated(0)
+	d.PRMerged()
+	d.ReviewerAssigned(domain.ReasonManual)
+	d.ReviewerReassigned(domain.ReasonDeactivation)
+	d.NoCandidate(domain.ReasonManual)
+
+	assertLines(t, scrape(t, m),
+		`reviewer_prs_created_total 2`,
+		`reviewer_prs_merged_total 1`,
+		`reviewer_reviewers_assigned_total{reason="auto"} 2`,
+		`reviewer_reviewers_assigned_total{reason="manual"} 1`,
+		`reviewer_reassignments_total{reason="deactivation"} 1`,
+		`reviewer_no_candidate_total{reason="manual"} 1`,
+		`reviewer_pr_assigned_reviewers_bucket{le="0"} 1`,
+		`reviewer_pr_assigned_reviewers_bucket{le="1"} 1`,
+		`reviewer_pr_assigned_reviewers_bucket{le="2"} 2`,
+	)
+}
@@ -0,0 +1,192 @@
+package migrate_test
+
+import (
+	"avito-2025/internal/migrate"
+	"avito-2025/internal/storage/sqlite"
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+// Каждая миграция зависит от предыдущей: в неверном порядке up или down упадёт.
+// Up не идемпотентны — повторное применение тоже даст ошибку.
+var testMigrations = fstest.MapFS{
+	"001_items.up.sql":        {Data: []byte(`CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT NOT NULL)`)},
+	"001_items.down.sql":      {Data: []byte(`DROP TABLE items`)},
+	"002_items_name.up.sql":   {Data: []byte(`CREATE UNIQUE INDEX idx_items_name ON items(name)`)},
+	"002_items_name.down.sql": {Data: []byte(`DROP INDEX idx_items_name`)},
+	"003_seed.up.sql":         {Data: []byte(`INSERT INTO items (name) VALUES ('first')`)},
+	"003_seed.down.sql":       {Data: []byte(`DELETE FROM items WHERE name = 'first'`)},
+	"README.md":               {Data: []byte("не миграция")},
+}
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "migrate.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newMigrator(t *testing.T, db *sql.DB, fsys fstest.MapFS) *migrate.Migrator {
+	t.Helper()
+	m, err := migrate.NewWithDialect(db, fsys, migrate.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func assertVersion(t *testing.T, m *migrate.Migrator, want int) {
+	t.Helper()
+	got, err := m.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("version: got %d, want %d", got, want)
+	}
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	m := newMigrator(t, db, testMigrations)
+
+	if m.Latest() != 3 {
+		t.Fatalf("latest: got %d, want 3", m.Latest())
+	}
+	assertVersion(t, m, 0)
+
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	assertVersion(t, m, 3)
+
+	// Повторный up ничего не применяет
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("second up: %v", err)
+	}
+	var rows int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM items`).Scan(&rows); err != nil || rows != 1 {
+		t.Fatalf("items after second up: %d, %v", rows, err)
+	}
+
+	// Down откатывает по одной миграции с конца
+	if err := m.Down(ctx); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	assertVersion(t, m, 2)
+
+	if err := m.Goto(ctx, 0); err != nil {
+		t.Fatalf("goto 0: %v", err)
+	}
+	assertVersion(t, m, 0)
+	if err := db.QueryRow(`SELECT COUNT(*) FROM items`).Scan(&rows); err == nil {
+		t.Fatal("items still exists after goto 0")
+	}
+
+	// Down на пустой базе — не ошибка
+	if err := m.Down(ctx); err != nil {
+		t.Fatalf("down at 0: %v", err)
+	}
+
+	if err := m.Goto(ctx, 2); err != nil {
+		t.Fatalf("goto 2: %v", err)
+	}
+	assertVersion(t, m, 2)
+
+	if err := m.Goto(ctx, 7); err == nil {
+		t.Fatal("goto unknown version: want error")
+	}
+}
+
+// ReadVersion не создаёт таблицу версий и сообщает, что её нет
+func TestReadVersion(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	m := newMigrator(t, db, testMigrations)
+
+	if _, err := m.ReadVersion(ctx); !errors.Is(err, migrate.ErrNoVersionTable) {
+		t.Fatalf("before migrations: got %v, want ErrNoVersionTable", err)
+	}
+	var tables int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE name = 'schema_migrations'`).Scan(&tables); err != nil || tables != 0 {
+		t.Fatalf("schema_migrations created by a read: %d, %v", tables, err)
+	}
+
+	if err := m.Goto(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	version, err := m.ReadVersion(ctx)
+	if err != nil || version != 2 {
+		t.Fatalf("after goto 2: got %d, %v", version, err)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+	m := newMigrator(t, openDB(t), testMigrations)
+
+	if err := m.Goto(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []bool{true, true, false}
+	if len(statuses) != len(want) {
+		t.Fatalf("got %d statuses, want %d", len(statuses), len(want))
+	}
+	for i, st := range statuses {
+		if st.Version != i+1 || st.Applied != want[i] || st.Applied == st.AppliedAt.IsZero() {
+			t.Errorf("status %d: %+v", i, st)
+		}
+	}
+}
+
+func TestGap(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+
+	// База ушла вперёд без миграции 002, потом 002 появилась в бинарнике
+	withoutSecond := fstest.MapFS{}
+	for name, f := range testMigrations {
+		if name != "002_items_name.up.sql" && name != "002_items_name.down.sql" {
+			withoutSecond[name] = f
+		}
+	}
+	if err := newMigrator(t, db, withoutSecond).Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	m := newMigrator(t, db, testMigrations)
+	if err := m.Up(ctx); !errors.Is(err, migrate.ErrGap) {
+		t.Fatalf("up: got %v, want ErrGap", err)
+	}
+	if err := m.Down(ctx); !errors.Is(err, migrate.ErrGap) {
+		t.Fatalf("down: got %v, want ErrGap", err)
+	}
+	if _, err := m.Version(ctx); !errors.Is(err, migrate.ErrGap) {
+		t.Fatalf("version: got %v, want ErrGap", err)
+	}
+	if _, err := m.ReadVersion(ctx); !errors.Is(err, migrate.ErrGap) {
+		t.Fatalf("read version: got %v, want ErrGap", err)
+	}
+}
+
+func TestMissingDownFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"001_items.up.sql": {Data: []byte(`CREATE TABLE items (id INTEGER PRIMARY KEY)`)},
+	}
+	if _, err := migrate.NewWithDialect(openDB(t), fsys, migrate.SQLite); err == nil {
+		t.Fatal("want error for a migration without down")
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import "context"
+
+// SystemActor — инициатор изменений, если запрос его не указал
+const SystemActor = "system"
+
+type actorKey struct{}
+
+// WithActor — запомнить в контексте, кто инициировал изменение (пишется в журнал назначений)
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFrom — инициатор из контекста или SystemActor
+func actorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"avito-2025/internal/storage"
+	"errors"
+	"fmt"
+)
+
+// Категории ошибок бизнес-логики: по ним HTTP-слой выбирает статус и код ответа.
+// ErrNotFound и ErrAlreadyExists совпадают с ошибками хранилища, поэтому
+// ошибка репозитория, не переведённая сервисом, классифицируется так же.
+var (
+	// ErrNotFound — запрошенной или упомянутой записи нет
+	ErrNotFound = storage.ErrNotFound
+	// ErrAlreadyExists — запись с таким ключом уже есть
+	ErrAlreadyExists = storage.ErrAlreadyExists
+	// ErrMerged — операция недоступна для смерженного PR
+	ErrMerged = errors.New("PR is merged")
+	// ErrNotAssigned — пользователь не назначен ревьювером этого PR
+	ErrNotAssigned = errors.New("reviewer is not assigned to this PR")
+	// ErrNoCandidate — в команде нет активного кандидата на замену
+	ErrNoCandidate = errors.New("no active replacement candidate in team")
+	// ErrValidation — некорректные входные данные
+	ErrValidation = errors.New("validation failed")
+)
+
+// Error — ошибка из категории Kind с сообщением для клиента.
+// errors.Is(err, ErrNotFound) и т.п. проверяет категорию.
+type Error struct {
+	Kind    error
+	Message string
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Kind }
+
+var (
+	// ErrPRExists — PR с таким pull_request_id уже создан
+	ErrPRExists = &Error{Kind: ErrAlreadyExists, Message: "PR already exists"}
+	// ErrTeamExists — команда с таким именем уже есть
+	ErrTeamExists = &Error{Kind: ErrAlreadyExists, Message: "team already exists"}
+	// ErrTeamNotFound — команды с таким именем нет
+	ErrTeamNotFound = &Error{Kind: ErrNotFound, Message: "team not found"}
+	// ErrPRNotFound — PR с таким ID нет
+	ErrPRNotFound = &Error{Kind: ErrNotFound, Message: "PR not found"}
+	// ErrUserNotFound — пользователя с таким ID нет
+	ErrUserNotFound = &Error{Kind: ErrNotFound, Message: "user not found"}
+	// ErrPRMerged — состав ревьюверов смерженного PR менять нельзя
+	ErrPRMerged = &Error{Kind: ErrMerged, Message: "cannot reassign on merged PR"}
+)
+
+// NotFound — ошибка категории ErrNotFound с сообщением
+func NotFound(message string) error {
+	return &Error{Kind: ErrNotFound, Message: message}
+}
+
+// Validation — ошибка категории ErrValidation с сообщением
+func Validation(format string, args ...interface{}) error {
+	return &Error{Kind: ErrValidation, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
+	"sort"
+	"time"
+)
+
+// Преобразования domain-моделей из storage в типы API
+
+func toAPIUser(u domain.User) *api.User {
+	return &api.User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func toAPITeamMember(u domain.User) api.TeamMember {
+	return api.TeamMember{
+		UserId:   u.ID,
+		Username: u.Username,
+		IsActive: u.IsActive,
+	}
+}
+
+func toAPITeamMembers(users []domain.User) []api.TeamMember {
+	members := make([]api.TeamMember, 0, len(users))
+	for _, u := range users {
+		members = append(members, toAPITeamMember(u))
+	}
+	return members
+}
+
+func toAPIPullRequest(pr domain.PullRequest, reviewers []string) *api.PullRequest {
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	return &api.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: reviewers,
+		CreatedAt:         timePtr(pr.CreatedAt),
+		MergedAt:          pr.MergedAt,
+	}
+}
+
+// timePtr — nil для нулевого времени, чтобы в JSON уходил null
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func toAPIUserStats(s domain.UserAssignmentStats) api.UserAssignmentStats {
+	return api.UserAssignmentStats{
+		UserId:           s.UserID,
+		Username:         s.Username,
+		TeamName:         s.TeamName,
+		OpenReviews:      s.OpenReviews,
+		TotalAssignments: s.TotalAssignments,
+		MergedReviewed:   s.MergedReviewed,
+		ReassignedAway:   s.ReassignedAway,
+	}
+}
+
+func toAPIPRStats(s domain.PRAssignmentStats) api.PRAssignmentStats {
+	out := api.PRAssignmentStats{
+		PullRequestId:   s.PRID,
+		PullRequestName: s.Name,
+		TeamName:        s.TeamName,
+		Status:          api.PRAssignmentStatsStatus(s.Status),
+		Reassignments:   s.Reassignments,
+	}
+	if s.TimeToMerge != nil {
+		seconds := int64(s.TimeToMerge.Seconds())
+		out.TimeToMergeSeconds = &seconds
+	}
+	return out
+}
+
+// toAPISelection — nil, если выбора не было (нет кандидатов)
+func toAPISelection(sel Selection) *api.ReviewerSelection {
+	if sel.Strategy == "" {
+		return nil
+	}
+
+	out := &api.ReviewerSelection{Strategy: api.ReviewerSelectionStrategy(sel.Strategy)}
+	if sel.Loads != nil {
+		loads := make([]api.ReviewerLoad, 0, len(sel.Loads))
+		for id, n := range sel.Loads {
+			loads = append(loads, api.ReviewerLoad{UserId: id, OpenReviews: n})
+		}
+		// Сначала наименее загруженные
+		sort.Slice(loads, func(i, j int) bool {
+			if loads[i].OpenReviews != loads[j].OpenReviews {
+				return loads[i].OpenReviews < loads[j].OpenReviews
+			}
+			return loads[i].UserId < loads[j].UserId
+		})
+		out.CandidateLoads = &loads
+	}
+	return out
+}
+
+func toAPIAssignmentEvent(e domain.AssignmentEvent) api.AssignmentEvent {
+	out := api.AssignmentEvent{
+		EventType: api.AssignmentEventEventType(e.Type),
+		Actor:     e.Actor,
+		Reason:    api.AssignmentEventReason(e.Reason),
+		CreatedAt: e.CreatedAt,
+	}
+	if e.OldReviewerID != "" {
+		old := e.OldReviewerID
+		out.OldReviewerId = &old
+	}
+	if e.NewReviewerID != "" {
+		newID := e.NewReviewerID
+		out.NewReviewerId = &newID
+	}
+	return out
+}
@@ -0,0 +1,20 @@
+package service
+
+// Metrics — события назначения ревьюверов для метрик; реализация — metrics.Domain.
+// Сервисы сообщают о них только после успешного коммита транзакции.
+type Metrics interface {
+	PRCreated(reviewers int)
+	PRMerged()
+	ReviewerAssigned(reason string)
+	ReviewerReassigned(reason string)
+	NoCandidate(reason string)
+}
+
+// NopMetrics — метрики не собираются
+type NopMetrics struct{}
+
+func (NopMetrics) PRCreated(int)             {}
+func (NopMetrics) PRMerged()                 {}
+func (NopMetrics) ReviewerAssigned(string)   {}
+func (NopMetrics) ReviewerReassigned(string) {}
+func (NopMetrics) NoCandidate(string)        {}
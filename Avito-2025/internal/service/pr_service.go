@@ -2,214 +2,391 @@ package service
 
 import (
 	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
 	"avito-2025/internal/storage"
 	"context"
 	"errors"
-	"math/rand"
-	"time"
+	"log/slog"
 )
 
+// maxReviewers — сколько ревьюверов назначается на PR при создании
+const maxReviewers = 2
+
 type PRService struct {
-	prRepo         *storage.PRRepository
-	prReviewerRepo *storage.PRReviewerRepository
-	userRepo       *storage.UserRepository
+	tx             TxRunner
+	prRepo         PRStore
+	prReviewerRepo ReviewerStore
+	userRepo       UserStore
+	eventRepo      EventStore
+	selectors      *TeamSelectors
+	metrics        Metrics
 }
 
-func NewPRService(prRepo *storage.PRRepository, prReviewerRepo *storage.PRReviewerRepository, userRepo *storage.UserRepository) *PRService {
-	return &PRService{prRepo: prRepo, prReviewerRepo: prReviewerRepo, userRepo: userRepo}
+func NewPRService(tx TxRunner, prRepo PRStore, prReviewerRepo ReviewerStore, userRepo UserStore, eventRepo EventStore, selectors *TeamSelectors, metrics Metrics) *PRService {
+	return &PRService{tx: tx, prRepo: prRepo, prReviewerRepo: prReviewerRepo, userRepo: userRepo, eventRepo: eventRepo, selectors: selectors, metrics: metrics}
 }
 
-// CreatePR — создать pull request
-func (s *PRService) CreatePR(ctx context.Context, name string, authorID string) (*api.PullRequest, error) {
+// CreatePR — создать pull request и назначить до двух ревьюверов из команды автора.
+// Вместе с PR возвращается информация о том, как выбраны ревьюверы.
+func (s *PRService) CreatePR(ctx context.Context, prID string, name string, authorID string) (*api.PullRequest, *api.ReviewerSelection, error) {
 	if name == "" {
-		return nil, errors.New("PR name cannot be empty")
+		return nil, nil, Validation("PR name cannot be empty")
 	}
 
-	// Проверяем, существует ли автор
-	author, err := s.userRepo.GetByID(ctx, authorID)
-	if err != nil || author == nil {
-		return nil, errors.New("author not found")
-	}
+	var created *domain.PullRequest
+	var selection Selection
+
+	// Проверки, выбор ревьюверов и запись — в одной транзакции: при повторе
+	// выбор делается заново по актуальному составу команды
+	err := s.tx.InTx(ctx, func(tx Stores) error {
+		// Проверяем, существует ли автор
+		author, err := tx.Users.GetByID(ctx, authorID)
+		if err != nil {
+			return err
+		}
+		if author == nil {
+			return NotFound("author not found")
+		}
+
+		// ID выбирает клиент — повторное создание запрещено
+		existing, err := tx.PRs.GetByID(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return ErrPRExists
+		}
+
+		// Получаем активных членов команды автора
+		members, err := tx.Users.GetActiveMembers(ctx, author.TeamName)
+		if err != nil {
+			return err
+		}
 
-	// Создаём PR
-	prID, err := s.prRepo.Create(ctx, name, authorID, string(api.PullRequestStatusOPEN))
+		// Кандидаты: все активные, кроме самого автора
+		candidates := make([]string, 0, len(members))
+		for _, m := range members {
+			if m.ID != authorID && m.IsActive {
+				candidates = append(candidates, m.ID)
+			}
+		}
+		// Нагрузку стратегия читает в этой же транзакции
+		selection, err = s.selectors.Select(withLoads(ctx, tx.Reviewers), author.TeamName, candidates, maxReviewers)
+		if err != nil {
+			return err
+		}
+
+		err = tx.PRs.Create(ctx, prID, name, authorID, domain.PRStatusOpen)
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			// Параллельный запрос успел создать PR с тем же ID
+			return ErrPRExists
+		}
+		if err != nil {
+			return err
+		}
+
+		events := make([]domain.AssignmentEvent, 0, len(selection.Picked))
+		for _, reviewerID := range selection.Picked {
+			if err := tx.Reviewers.AssignReviewer(ctx, prID, reviewerID); err != nil {
+				return err
+			}
+			events = append(events, domain.AssignmentEvent{
+				PRID: prID, Type: domain.EventAssigned, NewReviewerID: reviewerID,
+				Actor: actorFrom(ctx), Reason: domain.ReasonAuto,
+			})
+		}
+		if err := tx.Events.Append(ctx, events...); err != nil {
+			return err
+		}
+
+		// Отдаём сохранённую строку: created_at проставляет хранилище
+		created, err = tx.PRs.GetByID(ctx, prID)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if created == nil {
+		return nil, nil, ErrPRNotFound
 	}
+	s.metrics.PRCreated(len(selection.Picked))
+	slog.InfoContext(ctx, "PR создан", "pr_id", prID, "author_id", authorID,
+		"reviewers", selection.Picked, "strategy", selection.Strategy)
 
-	// Возвращаем в формате API
-	return &api.PullRequest{
-		PullRequestId:     prID,
-		PullRequestName:   name,
-		AuthorId:          authorID,
-		Status:            api.PullRequestStatusOPEN,
-		AssignedReviewers: []string{},
-	}, nil
+	return toAPIPullRequest(*created, selection.Picked), toAPISelection(selection), nil
 }
 
 // GetPR — получить PR
 func (s *PRService) GetPR(ctx context.Context, prID string) (*api.PullRequest, error) {
-	prMap, err := s.prRepo.GetByID(ctx, prID)
+	pr, err := s.prRepo.GetByID(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
 
-	if prMap == nil {
-		return nil, errors.New("PR not found")
+	if pr == nil {
+		return nil, ErrPRNotFound
 	}
 
 	// Получаем ревьюверов
 	reviewers, err := s.prReviewerRepo.GetByPR(ctx, prID)
 	if err != nil {
-		reviewers = []string{}
-	}
-
-	// Преобразуем статус
-	status := api.PullRequestStatusOPEN
-	if prMap["Status"].(string) == string(api.PullRequestStatusMERGED) {
-		status = api.PullRequestStatusMERGED
+		return nil, err
 	}
 
-	return &api.PullRequest{
-		PullRequestId:     prMap["ID"].(string),
-		PullRequestName:   prMap["Name"].(string),
-		AuthorId:          prMap["AuthorID"].(string),
-		Status:            status,
-		AssignedReviewers: reviewers,
-	}, nil
+	return toAPIPullRequest(*pr, reviewers), nil
 }
 
-// MergePR — мержить PR
-func (s *PRService) MergePR(ctx context.Context, prID string) error {
-	prMap, err := s.prRepo.GetByID(ctx, prID)
-	if err != nil || prMap == nil {
-		return errors.New("PR not found")
+// MergePR — мержить PR. Операция идемпотентна: повторный вызов
+// возвращает текущее состояние PR без ошибки.
+func (s *PRService) MergePR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	merged, err := s.prRepo.MarkMerged(ctx, prID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Проверяем, уже ли PR мержен
-	if prMap["Status"].(string) == string(api.PullRequestStatusMERGED) {
-		return errors.New("PR already merged")
+	if merged {
+		s.metrics.PRMerged()
+		slog.InfoContext(ctx, "PR смержен", "pr_id", prID)
 	}
 
-	// Обновляем статус на MERGED
-	return s.prRepo.UpdateStatus(ctx, prID, string(api.PullRequestStatusMERGED))
+	// Переход не выполнен: PR уже MERGED или его нет
+	if !merged {
+		pr, err := s.prRepo.GetByID(ctx, prID)
+		if err != nil {
+			return nil, err
+		}
+		if pr == nil {
+			return nil, ErrPRNotFound
+		}
+	}
+
+	return s.GetPR(ctx, prID)
 }
 
-// GetPRsWhereUserIsReviewer — получить все PR где юзер ревьювер
+// GetPRsWhereUserIsReviewer — получить все PR где юзер ревьювер.
+// PR и их ревьюверы читаются двумя запросами независимо от числа PR.
 func (s *PRService) GetPRsWhereUserIsReviewer(ctx context.Context, userID string) ([]api.PullRequest, error) {
-	prIDs, err := s.prReviewerRepo.GetPRsByReviewer(ctx, userID)
+	prs, err := s.prRepo.ListReviewedBy(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]api.PullRequest, 0, len(prIDs))
-	for _, prID := range prIDs {
-		pr, err := s.GetPR(ctx, prID)
-		if err != nil {
-			continue
-		}
-		result = append(result, *pr)
+	result := make([]api.PullRequest, 0, len(prs))
+	if len(prs) == 0 {
+		return result, nil
 	}
 
+	prIDs := make([]string, len(prs))
+	for i, pr := range prs {
+		prIDs[i] = pr.ID
+	}
+	reviewersByPR, err := s.prReviewerRepo.GetByPRs(ctx, prIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pr := range prs {
+		result = append(result, *toAPIPullRequest(pr, reviewersByPR[pr.ID]))
+	}
 	return result, nil
 }
 
-// AssignRandomReviewer — назначить случайного ревьювера
-func (s *PRService) AssignRandomReviewer(ctx context.Context, prID string, oldUserID string) (*api.TeamMember, error) {
-	prMap, err := s.prRepo.GetByID(ctx, prID)
-	if err != nil || prMap == nil {
-		return nil, errors.New("PR not found")
-	}
+// AssignRandomReviewer — заменить ревьювера oldUserID активным участником
+// из его команды, выбранным стратегией команды. Проверки и замена выполняются в одной транзакции
+// под блокировкой строки PR, поэтому merge или другой reassign не вклинится.
+func (s *PRService) AssignRandomReviewer(ctx context.Context, prID string, oldUserID string) (*api.TeamMember, *api.ReviewerSelection, error) {
+	var chosen domain.User
+	var selection Selection
 
-	// Проверяем что PR еще не мержен
-	if prMap["Status"].(string) == string(api.PullRequestStatusMERGED) {
-		return nil, errors.New("cannot reassign reviewer on merged PR")
-	}
+	err := s.tx.InTx(ctx, func(tx Stores) error {
+		pr, err := tx.PRs.GetForUpdate(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if pr == nil {
+			return ErrPRNotFound
+		}
 
-	// Получаем автора
-	authorID := prMap["AuthorID"].(string)
-	author, err := s.userRepo.GetByID(ctx, authorID)
-	if err != nil || author == nil {
-		return nil, errors.New("author not found")
-	}
+		// Проверяем что PR еще не мержен
+		if pr.Status == domain.PRStatusMerged {
+			return ErrPRMerged
+		}
 
-	// Получаем активных членов команды
-	members, err := s.userRepo.GetActiveMembers(ctx, author["TeamName"].(string))
-	if err != nil {
-		return nil, err
-	}
+		// Заменяемый ревьювер должен существовать и быть назначен на PR
+		oldReviewer, err := tx.Users.GetByID(ctx, oldUserID)
+		if err != nil {
+			return err
+		}
+		if oldReviewer == nil {
+			return ErrUserNotFound
+		}
 
-	// Фильтруем: исключаем автора и старого ревьювера
-	candidates := make([]map[string]interface{}, 0)
-	for _, m := range members {
-		mID := m["ID"].(string)
-		mIsActive := m["IsActive"].(bool)
-		if mID != authorID && mID != oldUserID && mIsActive {
-			candidates = append(candidates, m)
+		reviewers, err := tx.Reviewers.GetByPR(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if !contains(reviewers, oldUserID) {
+			return ErrNotAssigned
 		}
-	}
 
-	if len(candidates) == 0 {
-		return nil, errors.New("no available reviewers")
-	}
+		// Кандидаты — активные участники команды заменяемого ревьювера
+		members, err := tx.Users.GetActiveMembers(ctx, oldReviewer.TeamName)
+		if err != nil {
+			return err
+		}
+
+		// Исключаем автора, старого ревьювера и уже назначенных
+		candidates := make([]string, 0, len(members))
+		byID := make(map[string]domain.User, len(members))
+		for _, m := range members {
+			if m.ID != pr.AuthorID && m.IsActive && !contains(reviewers, m.ID) {
+				candidates = append(candidates, m.ID)
+				byID[m.ID] = m
+			}
+		}
 
-	// Случайный выбор
-	rand.Seed(time.Now().UnixNano())
-	chosen := candidates[rand.Intn(len(candidates))]
+		// Выбор по стратегии команды; нагрузка — из этой же транзакции
+		selection, err = s.selectors.Select(withLoads(ctx, tx.Reviewers), oldReviewer.TeamName, candidates, 1)
+		if err != nil {
+			return err
+		}
+		if len(selection.Picked) == 0 {
+			return ErrNoCandidate
+		}
+		chosen = byID[selection.Picked[0]]
 
-	// Удаляем старого ревьювера
-	if oldUserID != "" {
-		_ = s.prReviewerRepo.RemoveReviewer(ctx, prID, oldUserID)
-	}
+		replaced, err := tx.Reviewers.ReplaceReviewer(ctx, prID, oldUserID, chosen.ID)
+		if err != nil {
+			return err
+		}
+		if !replaced {
+			return ErrNotAssigned
+		}
 
-	// Назначаем нового
-	chosenID := chosen["ID"].(string)
-	err = s.prReviewerRepo.AssignReviewer(ctx, prID, chosenID)
+		return tx.Events.Append(ctx, domain.AssignmentEvent{
+			PRID: prID, Type: domain.EventReassigned, OldReviewerID: oldUserID, NewReviewerID: chosen.ID,
+			Actor: actorFrom(ctx), Reason: domain.ReasonManual,
+		})
+	})
+	if errors.Is(err, ErrNoCandidate) {
+		s.metrics.NoCandidate(domain.ReasonManual)
+		slog.InfoContext(ctx, "Нет кандидата на замену ревьювера", "pr_id", prID, "old_reviewer_id", oldUserID)
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	s.metrics.ReviewerReassigned(domain.ReasonManual)
+	slog.InfoContext(ctx, "Ревьювер переназначен", "pr_id", prID,
+		"old_reviewer_id", oldUserID, "new_reviewer_id", chosen.ID, "strategy", selection.Strategy)
 
-	return &api.TeamMember{
-		UserId:   chosenID,
-		Username: chosen["Username"].(string),
-		IsActive: chosen["IsActive"].(bool),
-	}, nil
+	member := toAPITeamMember(chosen)
+	return &member, toAPISelection(selection), nil
+}
+
+// contains — есть ли id в списке
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
 }
 
 // AssignReviewer — назначить ревьювера на PR
 func (s *PRService) AssignReviewer(ctx context.Context, prID string, reviewerID string) error {
 	// Проверяем, существует ли PR
-	prMap, err := s.prRepo.GetByID(ctx, prID)
-	if err != nil || prMap == nil {
-		return errors.New("PR not found")
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return err
+	}
+	if pr == nil {
+		return ErrPRNotFound
 	}
 
 	// Проверяем статус PR (нельзя назначать ревьюверов на merged PR)
-	if prMap["Status"].(string) == string(api.PullRequestStatusMERGED) {
-		return errors.New("cannot assign reviewers to merged PR")
+	if pr.Status == domain.PRStatusMerged {
+		return &Error{Kind: ErrMerged, Message: "cannot assign reviewers to merged PR"}
 	}
 
 	// Проверяем, существует ли ревьювер
 	reviewer, err := s.userRepo.GetByID(ctx, reviewerID)
-	if err != nil || reviewer == nil {
-		return errors.New("reviewer not found")
+	if err != nil {
+		return err
+	}
+	if reviewer == nil {
+		return NotFound("reviewer not found")
 	}
 
 	// Нельзя назначать автора на ревью своего PR
-	if prMap["AuthorID"].(string) == reviewerID {
-		return errors.New("author cannot review their own PR")
+	if pr.AuthorID == reviewerID {
+		return Validation("author cannot review their own PR")
 	}
 
 	// Нельзя назначать неактивного пользователя
-	if !reviewer["IsActive"].(bool) {
-		return errors.New("reviewer is not active")
+	if !reviewer.IsActive {
+		return Validation("reviewer is not active")
 	}
 
-	// Назначаем ревьювера
-	return s.prReviewerRepo.AssignReviewer(ctx, prID, reviewerID)
+	// Назначаем ревьювера и пишем событие в журнал
+	var assigned bool
+	err = s.tx.InTx(ctx, func(tx Stores) error {
+		err := tx.Reviewers.AssignReviewer(ctx, prID, reviewerID)
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			// Уже назначен — менять нечего
+			assigned = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		assigned = true
+		return tx.Events.Append(ctx, domain.AssignmentEvent{
+			PRID: prID, Type: domain.EventAssigned, NewReviewerID: reviewerID,
+			Actor: actorFrom(ctx), Reason: domain.ReasonManual,
+		})
+	})
+	if err == nil && assigned {
+		s.metrics.ReviewerAssigned(domain.ReasonManual)
+		slog.InfoContext(ctx, "Ревьювер назначен", "pr_id", prID, "reviewer_id", reviewerID)
+	}
+	return err
 }
 
 // RemoveReviewer — удалить ревьювера с PR
 func (s *PRService) RemoveReviewer(ctx context.Context, prID string, reviewerID string) error {
-	return s.prReviewerRepo.RemoveReviewer(ctx, prID, reviewerID)
+	return s.tx.InTx(ctx, func(tx Stores) error {
+		removed, err := tx.Reviewers.RemoveReviewer(ctx, prID, reviewerID)
+		if err != nil || !removed {
+			return err
+		}
+
+		return tx.Events.Append(ctx, domain.AssignmentEvent{
+			PRID: prID, Type: domain.EventUnassigned, OldReviewerID: reviewerID,
+			Actor: actorFrom(ctx), Reason: domain.ReasonManual,
+		})
+	})
+}
+
+// GetHistory — журнал назначений ревьюверов PR
+func (s *PRService) GetHistory(ctx context.Context, prID string) ([]api.AssignmentEvent, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrPRNotFound
+	}
+
+	events, err := s.eventRepo.ListByPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.AssignmentEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, toAPIAssignmentEvent(e))
+	}
+	return result, nil
 }
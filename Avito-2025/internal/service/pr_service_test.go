@@ -0,0 +1,293 @@
+package service_test
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/service"
+	"avito-2025/internal/storage/memory"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// failingPRs — хранилище PR, у которого ломается список ревью
+type failingPRs struct {
+	service.PRStore
+	err error
+}
+
+func (f failingPRs) ListReviewedBy(context.Context, string) ([]domain.PullRequest, error) {
+	return nil, f.err
+}
+
+func TestGetPRsWhereUserIsReviewer(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2")
+	f.openPR(t, "pr-2", "author", "u1", "u2")
+	f.openPR(t, "pr-1", "author", "u1")
+	f.openPR(t, "pr-3", "author", "u2")
+
+	prs, err := f.prs.GetPRsWhereUserIsReviewer(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prs) != 2 || prs[0].PullRequestId != "pr-1" || prs[1].PullRequestId != "pr-2" {
+		t.Fatalf("got %+v, want pr-1 and pr-2", prs)
+	}
+	if len(prs[1].AssignedReviewers) != 2 || prs[0].CreatedAt == nil {
+		t.Errorf("pr-2 reviewers %v, pr-1 createdAt %v", prs[1].AssignedReviewers, prs[0].CreatedAt)
+	}
+
+	none, err := f.prs.GetPRsWhereUserIsReviewer(ctx, "author")
+	if err != nil || none == nil || len(none) != 0 {
+		t.Fatalf("no reviews: got %v, %v; want an empty list", none, err)
+	}
+}
+
+// Ошибка хранилища не превращается в неполный список
+func TestGetPRsWhereUserIsReviewerStoreError(t *testing.T) {
+	f := newFixture(t, service.StrategyLeastLoaded)
+	boom := errors.New("connection reset")
+	prs := service.NewPRService(f.store, failingPRs{PRStore: f.st.PRs, err: boom},
+		f.st.Reviewers, f.st.Users, f.st.Events, nil, service.NopMetrics{})
+
+	if _, err := prs.GetPRsWhereUserIsReviewer(context.Background(), "u1"); !errors.Is(err, boom) {
+		t.Fatalf("got %v, want the store error", err)
+	}
+}
+
+// retryOnce — как TxManager после конфликта сериализации: первая попытка
+// выполняется целиком и откатывается, затем fn вызывается снова
+type retryOnce struct {
+	*memory.Store
+	attempts int
+}
+
+var errConflict = errors.New("serialization conflict")
+
+func (r *retryOnce) InTx(ctx context.Context, fn func(tx service.Stores) error) error {
+	err := r.Store.InTx(ctx, func(tx service.Stores) error {
+		r.attempts++
+		if err := fn(tx); err != nil {
+			return err
+		}
+		return errConflict
+	})
+	if !errors.Is(err, errConflict) {
+		return err
+	}
+	r.attempts++
+	return r.Store.InTx(ctx, fn)
+}
+
+// Выбор ревьюверов и запись повторяются вместе: после повтора нет ни дублей, ни потерь
+func TestRetriedTransactions(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2", "u3")
+
+	tx := &retryOnce{Store: f.store}
+	selectors, err := service.NewTeamSelectors(service.StrategyLeastLoaded, nil, rand.New(rand.NewSource(1)), f.st.Reviewers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prs := service.NewPRService(tx, f.st.PRs, f.st.Reviewers, f.st.Users, f.st.Events, selectors, service.NopMetrics{})
+	teams := service.NewTeamService(tx, f.st.Teams, f.st.Users, selectors, service.NopMetrics{})
+
+	pr, _, err := prs.CreatePR(ctx, "pr-1", "Add search", "author")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.attempts != 2 {
+		t.Fatalf("attempts: got %d, want 2", tx.attempts)
+	}
+	stored := f.reviewers(t, "pr-1")
+	if len(pr.AssignedReviewers) != 2 || fmt.Sprint(sorted(stored)) != fmt.Sprint(sorted(pr.AssignedReviewers)) {
+		t.Fatalf("response reviewers %v, stored %v", pr.AssignedReviewers, stored)
+	}
+
+	gone := stored[0]
+	result, err := teams.DeactivateTeam(ctx, "backend", []string{gone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Reassignments) != 1 {
+		t.Fatalf("report after retry: %+v", result.Reassignments)
+	}
+
+	history, err := prs.GetHistory(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("history: got %d events, want 2 assigned and 1 reassigned", len(history))
+	}
+}
+
+func sorted(ids []string) []string {
+	out := append([]string(nil), ids...)
+	sort.Strings(out)
+	return out
+}
+
+func TestCreatePR(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2", "u3", "u4")
+	if err := f.st.Users.Update(ctx, "u4", "name-u4", false); err != nil {
+		t.Fatal(err)
+	}
+	// u1 занят, u2 и u3 свободны, u4 неактивен
+	f.openPR(t, "busy", "u2", "u1")
+
+	pr, selection, err := f.prs.CreatePR(ctx, "pr-1", "Add search", "author")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Seed фиксирован, поэтому выбор точный
+	if fmt.Sprint(pr.AssignedReviewers) != "[u3 u2]" {
+		t.Fatalf("reviewers: got %v, want [u3 u2]", pr.AssignedReviewers)
+	}
+	if pr.Status != "OPEN" || pr.CreatedAt == nil || pr.MergedAt != nil {
+		t.Fatalf("pr: %+v", pr)
+	}
+	if selection.Strategy != service.StrategyLeastLoaded || selection.CandidateLoads == nil || len(*selection.CandidateLoads) != 3 {
+		t.Fatalf("selection: %+v", selection)
+	}
+
+	// Ответ совпадает с тем, что отдаёт GET
+	got, err := f.prs.GetPR(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.CreatedAt.Equal(*pr.CreatedAt) || fmt.Sprint(sorted(got.AssignedReviewers)) != "[u2 u3]" {
+		t.Fatalf("GET: %+v", got)
+	}
+
+	history, err := f.prs.GetHistory(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Reason != domain.ReasonAuto {
+		t.Fatalf("history: %+v", history)
+	}
+}
+
+func TestCreatePRErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author")
+
+	// Автор один в команде — PR создаётся без ревьюверов
+	pr, _, err := f.prs.CreatePR(ctx, "pr-1", "Solo", "author")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.AssignedReviewers == nil || len(pr.AssignedReviewers) != 0 {
+		t.Fatalf("reviewers: %v", pr.AssignedReviewers)
+	}
+
+	cases := []struct {
+		name   string
+		prID   string
+		title  string
+		author string
+		want   error
+	}{
+		{"duplicate", "pr-1", "Again", "author", service.ErrPRExists},
+		{"unknown author", "pr-2", "Ghost", "nobody", service.ErrNotFound},
+		{"empty name", "pr-3", "", "author", service.ErrValidation},
+	}
+	for _, c := range cases {
+		if _, _, err := f.prs.CreatePR(ctx, c.prID, c.title, c.author); !errors.Is(err, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+		}
+	}
+}
+
+func TestReassignReviewer(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2", "u3")
+	f.team(t, "solo", "lonely")
+	f.openPR(t, "pr-1", "author", "u1", "u2")
+
+	member, selection, err := f.prs.AssignRandomReviewer(ctx, "pr-1", "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Единственный кандидат: автор и оба ревьювера исключены
+	if member.UserId != "u3" || selection.Strategy != service.StrategyLeastLoaded {
+		t.Fatalf("got %+v, %+v", member, selection)
+	}
+	if got := sorted(f.reviewers(t, "pr-1")); fmt.Sprint(got) != "[u2 u3]" {
+		t.Fatalf("reviewers: %v", got)
+	}
+
+	history, err := f.prs.GetHistory(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := history[len(history)-1]
+	if last.OldReviewerId == nil || *last.OldReviewerId != "u1" || last.NewReviewerId == nil || *last.NewReviewerId != "u3" {
+		t.Fatalf("event: %+v", last)
+	}
+
+	f.openPR(t, "pr-2", "author", "lonely")
+	cases := []struct {
+		name     string
+		prID     string
+		reviewer string
+		want     error
+	}{
+		{"not assigned", "pr-1", "u1", service.ErrNotAssigned},
+		{"no candidate", "pr-2", "lonely", service.ErrNoCandidate},
+		{"unknown PR", "nope", "u2", service.ErrNotFound},
+		{"unknown user", "pr-1", "nobody", service.ErrNotFound},
+	}
+	for _, c := range cases {
+		if _, _, err := f.prs.AssignRandomReviewer(ctx, c.prID, c.reviewer); !errors.Is(err, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+		}
+	}
+
+	// Неудачные попытки ничего не меняют
+	if got := sorted(f.reviewers(t, "pr-2")); fmt.Sprint(got) != "[lonely]" {
+		t.Fatalf("pr-2 reviewers: %v", got)
+	}
+}
+
+func TestMergePR(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2")
+	f.openPR(t, "pr-1", "author", "u1")
+
+	merged, err := f.prs.MergePR(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.Status != "MERGED" || merged.MergedAt == nil {
+		t.Fatalf("merged: %+v", merged)
+	}
+
+	// Повторный merge — то же состояние без ошибки, merged_at не меняется
+	again, err := f.prs.MergePR(ctx, "pr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Status != "MERGED" || !again.MergedAt.Equal(*merged.MergedAt) {
+		t.Fatalf("second merge: %+v", again)
+	}
+
+	if _, _, err := f.prs.AssignRandomReviewer(ctx, "pr-1", "u1"); !errors.Is(err, service.ErrMerged) {
+		t.Fatalf("reassign on merged PR: got %v", err)
+	}
+	if _, err := f.prs.MergePR(ctx, "nope"); !errors.Is(err, service.ErrNotFound) {
+		t.Fatalf("merge unknown PR: got %v", err)
+	}
+}
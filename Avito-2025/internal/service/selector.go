@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// ReviewerSelector — стратегия выбора до n ревьюверов из кандидатов команды.
+// Кандидаты уже отфильтрованы: активные, без автора и текущих ревьюверов.
+type ReviewerSelector interface {
+	Select(ctx context.Context, teamName string, candidates []string, n int) (Selection, error)
+}
+
+// Selection — результат выбора и данные, на которые опиралась стратегия
+type Selection struct {
+	Strategy string
+	Picked   []string
+	Loads    map[string]int // нагрузка каждого кандидата; nil, если стратегия её не учитывает
+}
+
+// LoadCounter — источник текущей нагрузки: число OPEN PR на ревью у каждого пользователя
+type LoadCounter interface {
+	OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+}
+
+// Имена встроенных стратегий
+const (
+	StrategyRandom         = "random"
+	StrategyLeastLoaded    = "least_loaded"
+	StrategyRoundRobin     = "round_robin"
+	StrategyWeightedRandom = "weighted_random"
+)
+
+// NewSelector — создать встроенную стратегию по имени.
+// rng общий для всех стратегий; передайте rand.New(rand.NewSource(seed)) для воспроизводимого выбора.
+func NewSelector(name string, rng *rand.Rand, loads LoadCounter) (ReviewerSelector, error) {
+	r := &lockedRand{rng: rng}
+
+	switch name {
+	case StrategyRandom:
+		return &RandomSelector{rng: r}, nil
+	case StrategyLeastLoaded:
+		return &LeastLoadedSelector{rng: r, loads: loads}, nil
+	case StrategyRoundRobin:
+		return NewRoundRobinSelector(), nil
+	case StrategyWeightedRandom:
+		return &WeightedRandomSelector{rng: r, loads: loads}, nil
+	default:
+		return nil, fmt.Errorf("unknown reviewer strategy %q", name)
+	}
+}
+
+// TeamSelectors — стратегия по умолчанию и переопределения для отдельных команд
+type TeamSelectors struct {
+	Default ReviewerSelector
+	ByTeam  map[string]ReviewerSelector
+}
+
+// For — стратегия для команды
+func (t *TeamSelectors) For(teamName string) ReviewerSelector {
+	if s, ok := t.ByTeam[teamName]; ok {
+		return s
+	}
+	return t.Default
+}
+
+// Select — выбрать ревьюверов стратегией команды
+func (t *TeamSelectors) Select(ctx context.Context, teamName string, candidates []string, n int) (Selection, error) {
+	if n <= 0 || len(candidates) == 0 {
+		return Selection{Picked: []string{}}, nil
+	}
+	return t.For(teamName).Select(ctx, teamName, candidates, n)
+}
+
+// IsStrategy — есть ли встроенная стратегия с таким именем
+func IsStrategy(name string) bool {
+	switch name {
+	case StrategyRandom, StrategyLeastLoaded, StrategyRoundRobin, StrategyWeightedRandom:
+		return true
+	}
+	return false
+}
+
+// NewTeamSelectors — стратегия по умолчанию и переопределения team -> strategy
+func NewTeamSelectors(defaultName string, byTeam map[string]string, rng *rand.Rand, loads LoadCounter) (*TeamSelectors, error) {
+	def, err := NewSelector(defaultName, rng, loads)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamSelectors{Default: def, ByTeam: make(map[string]ReviewerSelector, len(byTeam))}
+	for team, name := range byTeam {
+		sel, err := NewSelector(name, rng, loads)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %w", team, err)
+		}
+		result.ByTeam[team] = sel
+	}
+
+	return result, nil
+}
+
+// lockedRand — *rand.Rand не потокобезопасен, а стратегии вызываются из разных запросов
+type lockedRand struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func (r *lockedRand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rng.Float64()
+}
+
+// Shuffle — перемешать ids на месте
+func (r *lockedRand) Shuffle(ids []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rng.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+}
+
+type loadsKey struct{}
+
+// withLoads — читать нагрузку из loads вместо источника, заданного при создании стратегии.
+// Так сервис передаёт нагрузку, прочитанную в своей транзакции, или снимок, который сам обновляет.
+func withLoads(ctx context.Context, loads LoadCounter) context.Context {
+	return context.WithValue(ctx, loadsKey{}, loads)
+}
+
+// loadSnapshot — нагрузка, прочитанная один раз на пачку назначений.
+// Сервис увеличивает счётчик после каждого назначения, чтобы следующий выбор его учитывал.
+type loadSnapshot map[string]int
+
+func (l loadSnapshot) OpenReviewCounts(_ context.Context, userIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(userIDs))
+	for _, id := range userIDs {
+		counts[id] = l[id]
+	}
+	return counts, nil
+}
+
+// candidateLoads — нагрузка всех кандидатов одним запросом, с нулями для свободных
+func candidateLoads(ctx context.Context, loads LoadCounter, candidates []string) (map[string]int, error) {
+	if override, ok := ctx.Value(loadsKey{}).(LoadCounter); ok {
+		loads = override
+	}
+	counts, err := loads.OpenReviewCounts(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int, len(candidates))
+	for _, id := range candidates {
+		result[id] = counts[id]
+	}
+	return result, nil
+}
+
+// RandomSelector — равновероятный выбор без повторов
+type RandomSelector struct {
+	rng *lockedRand
+}
+
+func (s *RandomSelector) Select(_ context.Context, _ string, candidates []string, n int) (Selection, error) {
+	pool := append([]string(nil), candidates...)
+	s.rng.Shuffle(pool)
+
+	if len(pool) > n {
+		pool = pool[:n]
+	}
+	return Selection{Strategy: StrategyRandom, Picked: pool}, nil
+}
+
+// LeastLoadedSelector — кандидаты с наименьшим числом открытых ревью.
+// При равной нагрузке выбор случайный, чтобы не нагружать всегда одних и тех же.
+type LeastLoadedSelector struct {
+	rng   *lockedRand
+	loads LoadCounter
+}
+
+func (s *LeastLoadedSelector) Select(ctx context.Context, _ string, candidates []string, n int) (Selection, error) {
+	counts, err := candidateLoads(ctx, s.loads, candidates)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	// Перемешиваем, затем стабильно сортируем по нагрузке: равные остаются в случайном порядке
+	sorted := append([]string(nil), candidates...)
+	s.rng.Shuffle(sorted)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return counts[sorted[i]] < counts[sorted[j]]
+	})
+
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return Selection{Strategy: StrategyLeastLoaded, Picked: sorted, Loads: counts}, nil
+}
+
+// RoundRobinSelector — по кругу внутри команды: следующий после последнего выбранного.
+// Позиция хранится в памяти процесса.
+type RoundRobinSelector struct {
+	mu   sync.Mutex
+	last map[string]string // команда -> последний выбранный user_id
+}
+
+func NewRoundRobinSelector() *RoundRobinSelector {
+	return &RoundRobinSelector{last: make(map[string]string)}
+}
+
+func (s *RoundRobinSelector) Select(_ context.Context, teamName string, candidates []string, n int) (Selection, error) {
+	sorted := append([]string(nil), candidates...)
+	sort.Strings(sorted)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Начинаем с первого кандидата после последнего выбранного
+	start := sort.SearchStrings(sorted, s.last[teamName])
+	if start < len(sorted) && sorted[start] == s.last[teamName] {
+		start++
+	}
+
+	picked := make([]string, 0, n)
+	for i := 0; i < len(sorted) && len(picked) < n; i++ {
+		picked = append(picked, sorted[(start+i)%len(sorted)])
+	}
+
+	if len(picked) > 0 {
+		s.last[teamName] = picked[len(picked)-1]
+	}
+	return Selection{Strategy: StrategyRoundRobin, Picked: picked}, nil
+}
+
+// WeightedRandomSelector — случайный выбор с весом 1/(1+открытые ревью):
+// свободные выбираются чаще, но нагруженные не исключаются совсем
+type WeightedRandomSelector struct {
+	rng   *lockedRand
+	loads LoadCounter
+}
+
+func (s *WeightedRandomSelector) Select(ctx context.Context, _ string, candidates []string, n int) (Selection, error) {
+	counts, err := candidateLoads(ctx, s.loads, candidates)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	pool := append([]string(nil), candidates...)
+	picked := make([]string, 0, n)
+
+	for len(picked) < n && len(pool) > 0 {
+		total := 0.0
+		for _, id := range pool {
+			total += 1 / float64(1+counts[id])
+		}
+
+		// Последний элемент — на случай погрешности округления
+		i := len(pool) - 1
+		target := s.rng.Float64() * total
+		for j, id := range pool {
+			target -= 1 / float64(1+counts[id])
+			if target < 0 {
+				i = j
+				break
+			}
+		}
+
+		picked = append(picked, pool[i])
+		pool = append(pool[:i], pool[i+1:]...)
+	}
+	return Selection{Strategy: StrategyWeightedRandom, Picked: picked, Loads: counts}, nil
+}
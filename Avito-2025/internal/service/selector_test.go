@@ -0,0 +1,228 @@
+package service_test
+
+import (
+	"avito-2025/internal/service"
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// fakeLoads — нагрузка из таблицы; считает обращения
+type fakeLoads struct {
+	counts map[string]int
+	err    error
+	calls  int
+}
+
+func (f *fakeLoads) OpenReviewCounts(_ context.Context, userIDs []string) (map[string]int, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	result := make(map[string]int)
+	for _, id := range userIDs {
+		if n, ok := f.counts[id]; ok {
+			result[id] = n
+		}
+	}
+	return result, nil
+}
+
+func newSelector(t *testing.T, name string, seed int64, loads map[string]int) service.ReviewerSelector {
+	t.Helper()
+	sel, err := service.NewSelector(name, rand.New(rand.NewSource(seed)), &fakeLoads{counts: loads})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sel
+}
+
+func TestSelectorPicks(t *testing.T) {
+	candidates := []string{"u1", "u2", "u3", "u4", "u5"}
+	loads := map[string]int{"u1": 3, "u2": 0, "u3": 1, "u4": 0, "u5": 7}
+
+	cases := []struct {
+		name     string
+		strategy string
+		seed     int64
+		n        int
+		want     []string
+	}{
+		{"random", service.StrategyRandom, 1, 2, []string{"u3", "u1"}},
+		{"random other seed", service.StrategyRandom, 42, 2, []string{"u3", "u4"}},
+		{"least loaded", service.StrategyLeastLoaded, 1, 3, []string{"u2", "u4", "u3"}},
+		{"least loaded other seed", service.StrategyLeastLoaded, 42, 3, []string{"u4", "u2", "u3"}},
+		{"round robin", service.StrategyRoundRobin, 1, 2, []string{"u1", "u2"}},
+		{"weighted random", service.StrategyWeightedRandom, 1, 2, []string{"u3", "u4"}},
+		{"weighted random other seed", service.StrategyWeightedRandom, 42, 2, []string{"u2", "u1"}},
+		{"more than candidates", service.StrategyLeastLoaded, 1, 10, []string{"u2", "u4", "u3", "u1", "u5"}},
+		{"more than candidates random", service.StrategyRandom, 1, 10, []string{"u3", "u1", "u2", "u5", "u4"}},
+		{"more than candidates weighted", service.StrategyWeightedRandom, 1, 10, []string{"u3", "u4", "u2", "u1", "u5"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sel := newSelector(t, c.strategy, c.seed, loads)
+			got, err := sel.Select(context.Background(), "backend", candidates, c.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Strategy != c.strategy || !reflect.DeepEqual(got.Picked, c.want) {
+				t.Fatalf("got %s %v, want %s %v", got.Strategy, got.Picked, c.strategy, c.want)
+			}
+
+			// Тот же seed — тот же выбор
+			again, err := newSelector(t, c.strategy, c.seed, loads).Select(context.Background(), "backend", candidates, c.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(again.Picked, got.Picked) {
+				t.Fatalf("same seed, different picks: %v and %v", got.Picked, again.Picked)
+			}
+		})
+	}
+}
+
+// При равной нагрузке least_loaded выбирает случайно среди наименее загруженных,
+// а более загруженных не выбирает никогда
+func TestLeastLoadedBreaksTiesByShuffle(t *testing.T) {
+	loads := map[string]int{"u1": 0, "u2": 0, "u3": 0, "u4": 2}
+	candidates := []string{"u1", "u2", "u3", "u4"}
+
+	picked := make(map[string]bool)
+	for seed := int64(1); seed <= 30; seed++ {
+		got, err := newSelector(t, service.StrategyLeastLoaded, seed, loads).Select(context.Background(), "backend", candidates, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Loads["u4"] != 2 || got.Loads["u1"] != 0 {
+			t.Fatalf("loads: %v", got.Loads)
+		}
+		picked[got.Picked[0]] = true
+	}
+
+	if !reflect.DeepEqual(picked, map[string]bool{"u1": true, "u2": true, "u3": true}) {
+		t.Fatalf("picked across seeds: %v, want every tied candidate and never u4", picked)
+	}
+}
+
+// Round-robin идёт по кругу внутри команды; у каждой команды своя позиция
+func TestRoundRobinWrapsPerTeam(t *testing.T) {
+	ctx := context.Background()
+	sel := newSelector(t, service.StrategyRoundRobin, 1, nil)
+	candidates := []string{"u3", "u1", "u2"}
+
+	steps := []struct {
+		team       string
+		candidates []string
+		n          int
+		want       []string
+	}{
+		{"backend", candidates, 1, []string{"u1"}},
+		{"backend", candidates, 1, []string{"u2"}},
+		{"payments", candidates, 1, []string{"u1"}},
+		{"backend", candidates, 2, []string{"u3", "u1"}},
+		{"backend", candidates, 5, []string{"u2", "u3", "u1"}},
+		// Последний выбранный (u1) выбыл: продолжаем со следующего после него
+		{"backend", []string{"u2", "u3"}, 1, []string{"u2"}},
+		{"payments", candidates, 2, []string{"u2", "u3"}},
+	}
+
+	for i, s := range steps {
+		got, err := sel.Select(ctx, s.team, s.candidates, s.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Picked, s.want) {
+			t.Fatalf("step %d (%s): got %v, want %v", i, s.team, got.Picked, s.want)
+		}
+	}
+}
+
+func TestSelectorLoadError(t *testing.T) {
+	boom := errors.New("db is down")
+	for _, name := range []string{service.StrategyLeastLoaded, service.StrategyWeightedRandom} {
+		sel, err := service.NewSelector(name, rand.New(rand.NewSource(1)), &fakeLoads{err: boom})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := sel.Select(context.Background(), "backend", []string{"u1"}, 1); !errors.Is(err, boom) {
+			t.Errorf("%s: got %v, want the load error", name, err)
+		}
+	}
+}
+
+func TestTeamSelectors(t *testing.T) {
+	ctx := context.Background()
+	loads := &fakeLoads{counts: map[string]int{"u1": 1}}
+
+	ts, err := service.NewTeamSelectors(service.StrategyRandom,
+		map[string]string{"payments": service.StrategyLeastLoaded}, rand.New(rand.NewSource(1)), loads)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for team, want := range map[string]string{"payments": service.StrategyLeastLoaded, "backend": service.StrategyRandom} {
+		got, err := ts.For(team).Select(ctx, team, []string{"u1", "u2"}, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Strategy != want {
+			t.Errorf("%s: got strategy %s, want %s", team, got.Strategy, want)
+		}
+	}
+
+	// Без кандидатов стратегия не вызывается
+	calls := loads.calls
+	got, err := ts.Select(ctx, "payments", nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Picked == nil || len(got.Picked) != 0 || loads.calls != calls {
+		t.Fatalf("empty candidates: got %+v after %d load calls", got, loads.calls-calls)
+	}
+}
+
+func TestUnknownStrategy(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	if _, err := service.NewSelector("fastest", rng, nil); err == nil {
+		t.Error("NewSelector: want error for an unknown strategy")
+	}
+	if service.IsStrategy("fastest") || !service.IsStrategy(service.StrategyWeightedRandom) {
+		t.Error("IsStrategy")
+	}
+	if _, err := service.NewTeamSelectors("fastest", nil, rng, nil); err == nil {
+		t.Error("NewTeamSelectors: want error for an unknown default")
+	}
+	if _, err := service.NewTeamSelectors(service.StrategyRandom, map[string]string{"backend": "fastest"}, rng, nil); err == nil {
+		t.Error("NewTeamSelectors: want error for an unknown team strategy")
+	}
+}
+
+// Weighted random предпочитает свободных, но не исключает нагруженных
+func TestWeightedRandomPrefersFreeReviewers(t *testing.T) {
+	sel := newSelector(t, service.StrategyWeightedRandom, 7, map[string]int{"busy": 9})
+
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		got, err := sel.Select(context.Background(), "backend", []string{"free", "busy"}, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[got.Picked[0]]++
+	}
+
+	// Веса 1 и 1/10: busy выпадает примерно в 9% случаев
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"busy", "free"}) || counts["busy"] < 40 || counts["busy"] > 150 {
+		t.Fatalf("picks: %v", counts)
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
+	"context"
+)
+
+type StatsService struct {
+	statsRepo StatsStore
+	teamRepo  TeamStore
+}
+
+func NewStatsService(statsRepo StatsStore, teamRepo TeamStore) *StatsService {
+	return &StatsService{statsRepo: statsRepo, teamRepo: teamRepo}
+}
+
+// GetAssignmentStats — статистика назначений по пользователям и PR
+func (s *StatsService) GetAssignmentStats(ctx context.Context, filter domain.StatsFilter) (*api.AssignmentStats, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, Validation("from must be before to")
+	}
+
+	// Несуществующая команда — ошибка, а не пустая статистика
+	if filter.TeamName != "" {
+		team, err := s.teamRepo.GetByName(ctx, filter.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		if team == nil {
+			return nil, ErrTeamNotFound
+		}
+	}
+
+	users, err := s.statsRepo.UserStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	prs, err := s.statsRepo.PRStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api.AssignmentStats{
+		Users:        make([]api.UserAssignmentStats, 0, len(users)),
+		PullRequests: make([]api.PRAssignmentStats, 0, len(prs)),
+	}
+	for _, u := range users {
+		result.Users = append(result.Users, toAPIUserStats(u))
+	}
+	for _, pr := range prs {
+		result.PullRequests = append(result.PullRequests, toAPIPRStats(pr))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/service"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Статистика после полного цикла через сервисы: создание, переназначение, merge
+func TestAssignmentStats(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "u1", "u2")
+	f.team(t, "mobile", "m1")
+
+	// Кандидатов ровно двое — назначаются оба
+	if _, _, err := f.prs.CreatePR(ctx, "pr-1", "Add search", "author"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.st.Users.Upsert(ctx, "u3", "name-u3", "backend", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := f.prs.AssignRandomReviewer(ctx, "pr-1", "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.prs.MergePR(ctx, "pr-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := f.stats.GetAssignmentStats(ctx, domain.StatsFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type counts struct{ total, away, open, merged int }
+	want := map[string]counts{
+		"author": {},
+		"u1":     {total: 1, away: 1},
+		"u2":     {total: 1, merged: 1},
+		"u3":     {total: 1, merged: 1},
+		"m1":     {},
+	}
+	if len(stats.Users) != len(want) {
+		t.Fatalf("got %d users, want %d", len(stats.Users), len(want))
+	}
+	for _, u := range stats.Users {
+		got := counts{u.TotalAssignments, u.ReassignedAway, u.OpenReviews, u.MergedReviewed}
+		if got != want[u.UserId] {
+			t.Errorf("%s: got %+v, want %+v", u.UserId, got, want[u.UserId])
+		}
+	}
+
+	if len(stats.PullRequests) != 1 {
+		t.Fatalf("got %d PRs, want 1", len(stats.PullRequests))
+	}
+	pr := stats.PullRequests[0]
+	if pr.Reassignments != 1 || pr.Status != "MERGED" || pr.TeamName != "backend" || pr.TimeToMergeSeconds == nil {
+		t.Fatalf("pr stats: %+v", pr)
+	}
+
+	// Фильтр по команде
+	mobile, err := f.stats.GetAssignmentStats(ctx, domain.StatsFilter{TeamName: "mobile"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mobile.Users) != 1 || mobile.Users[0].UserId != "m1" || len(mobile.PullRequests) != 0 {
+		t.Fatalf("mobile: %+v", mobile)
+	}
+}
+
+func TestAssignmentStatsErrors(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author")
+
+	// Пустая база — пустые списки, а не nil
+	empty, err := f.stats.GetAssignmentStats(ctx, domain.StatsFilter{TeamName: "backend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.PullRequests == nil || len(empty.Users) != 1 {
+		t.Fatalf("empty: %+v", empty)
+	}
+
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	cases := []struct {
+		name   string
+		filter domain.StatsFilter
+		want   error
+	}{
+		{"from after to", domain.StatsFilter{From: &now, To: &earlier}, service.ErrValidation},
+		{"empty period", domain.StatsFilter{From: &now, To: &now}, service.ErrValidation},
+		{"unknown team", domain.StatsFilter{TeamName: "nope"}, service.ErrNotFound},
+	}
+	for _, c := range cases {
+		if _, err := f.stats.GetAssignmentStats(ctx, c.filter); !errors.Is(err, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+)
+
+// Контракты хранилищ, от которых зависят сервисы. Им удовлетворяют репозитории
+// из internal/storage; общий набор проверок — internal/storage/storetest.
+// Соглашения: поиск по ключу возвращает (nil, nil), если записи нет;
+// вставка дубликата возвращает storage.ErrAlreadyExists.
+
+// UserStore — пользователи
+type UserStore interface {
+	GetByID(ctx context.Context, userID string) (*domain.User, error)
+	Upsert(ctx context.Context, userID string, username string, teamName string, isActive bool) error
+	Update(ctx context.Context, userID string, username string, isActive bool) error
+	GetByTeam(ctx context.Context, teamName string) ([]domain.User, error)
+	GetActiveMembers(ctx context.Context, teamName string) ([]domain.User, error)
+	DeactivateMembers(ctx context.Context, teamName string, userIDs []string) ([]string, error)
+}
+
+// TeamStore — команды
+type TeamStore interface {
+	Create(ctx context.Context, name string) error
+	GetByName(ctx context.Context, name string) (*domain.Team, error)
+	List(ctx context.Context) ([]domain.Team, error)
+	Update(ctx context.Context, oldName string, newName string) error
+	Delete(ctx context.Context, name string) error
+}
+
+// PRStore — pull request'ы
+type PRStore interface {
+	Create(ctx context.Context, prID string, prName string, authorID string, status string) error
+	GetByID(ctx context.Context, prID string) (*domain.PullRequest, error)
+	GetForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error)
+	MarkMerged(ctx context.Context, prID string) (bool, error)
+	LockOpenReviewedBy(ctx context.Context, reviewerIDs []string) ([]domain.PullRequest, error)
+	ListReviewedBy(ctx context.Context, reviewerID string) ([]domain.PullRequest, error)
+}
+
+// ReviewerStore — назначения ревьюверов
+type ReviewerStore interface {
+	LoadCounter
+
+	AssignReviewer(ctx context.Context, prID string, reviewerID string) error
+	RemoveReviewer(ctx context.Context, prID string, reviewerID string) (bool, error)
+	ReplaceReviewer(ctx context.Context, prID string, oldID string, newID string) (bool, error)
+	ReplaceReviewers(ctx context.Context, swaps []storage.ReviewerSwap) error
+	RemoveReviewers(ctx context.Context, slots []storage.ReviewerSwap) error
+	GetByPR(ctx context.Context, prID string) ([]string, error)
+	GetByPRs(ctx context.Context, prIDs []string) (map[string][]string, error)
+	GetPRsByReviewer(ctx context.Context, reviewerID string) ([]string, error)
+}
+
+// EventStore — журнал назначений
+type EventStore interface {
+	Append(ctx context.Context, events ...domain.AssignmentEvent) error
+	ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error)
+}
+
+// StatsStore — агрегаты для статистики назначений
+type StatsStore interface {
+	UserStats(ctx context.Context, f domain.StatsFilter) ([]domain.UserAssignmentStats, error)
+	PRStats(ctx context.Context, f domain.StatsFilter) ([]domain.PRAssignmentStats, error)
+}
+
+// Stores — хранилища, работающие внутри одной транзакции
+type Stores struct {
+	Users     UserStore
+	Teams     TeamStore
+	PRs       PRStore
+	Reviewers ReviewerStore
+	Events    EventStore
+}
+
+// TxRunner — выполняет fn атомарно: все изменения через Stores применяются целиком или не применяются.
+// fn может быть вызвана повторно, поэтому не должна иметь побочных эффектов вне хранилищ.
+// Выбор ревьюверов сервисы делают внутри fn и с нагрузкой из tx: при повторе он повторяется
+// по свежим данным. Единственный побочный эффект — сдвиг позиции round_robin, он безвреден.
+type TxRunner interface {
+	InTx(ctx context.Context, fn func(tx Stores) error) error
+}
+
+// postgresTx — TxRunner поверх storage.TxManager
+type postgresTx struct {
+	m *storage.TxManager
+}
+
+// NewPostgresTx — TxRunner для репозиториев Postgres
+func NewPostgresTx(m *storage.TxManager) TxRunner {
+	return postgresTx{m: m}
+}
+
+func (p postgresTx) InTx(ctx context.Context, fn func(tx Stores) error) error {
+	return p.m.Do(ctx, func(uow *storage.UnitOfWork) error {
+		return fn(Stores{
+			Users:     uow.Users,
+			Teams:     uow.Teams,
+			PRs:       uow.PRs,
+			Reviewers: uow.Reviewers,
+			Events:    uow.Events,
+		})
+	})
+}
+
+// Проверка на этапе компиляции, что репозитории Postgres выполняют контракты
+var (
+	_ UserStore     = (*storage.UserRepository)(nil)
+	_ TeamStore     = (*storage.TeamRepository)(nil)
+	_ PRStore       = (*storage.PRRepository)(nil)
+	_ ReviewerStore = (*storage.PRReviewerRepository)(nil)
+	_ EventStore    = (*storage.AssignmentEventRepository)(nil)
+	_ StatsStore    = (*storage.StatsRepository)(nil)
+)
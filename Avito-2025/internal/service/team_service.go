@@ -2,73 +2,226 @@ package service
 
 import (
 	"avito-2025/internal/api"
+	"avito-2025/internal/domain"
 	"avito-2025/internal/storage"
 	"context"
 	"errors"
+	"log/slog"
 )
 
 type TeamService struct {
-	teamRepo *storage.TeamRepository
-	userRepo *storage.UserRepository
+	tx        TxRunner
+	teamRepo  TeamStore
+	userRepo  UserStore
+	selectors *TeamSelectors
+	metrics   Metrics
 }
 
-func NewTeamService(teamRepo *storage.TeamRepository, userRepo *storage.UserRepository) *TeamService {
-	return &TeamService{teamRepo: teamRepo, userRepo: userRepo}
+func NewTeamService(tx TxRunner, teamRepo TeamStore, userRepo UserStore, selectors *TeamSelectors, metrics Metrics) *TeamService {
+	return &TeamService{tx: tx, teamRepo: teamRepo, userRepo: userRepo, selectors: selectors, metrics: metrics}
 }
 
-// CreateTeam — создать команду
-func (s *TeamService) CreateTeam(ctx context.Context, name string) (*api.Team, error) {
-	if name == "" {
-		return nil, errors.New("team name cannot be empty")
+// CreateTeam — создать команду и создать/обновить её участников.
+// Участник из другой команды переходит в новую.
+func (s *TeamService) CreateTeam(ctx context.Context, team api.Team) (*api.Team, error) {
+	if team.TeamName == "" {
+		return nil, Validation("team name cannot be empty")
 	}
 
-	// Создаём команду в storage
-	err := s.teamRepo.Create(ctx, name)
+	// Команда и все участники сохраняются атомарно
+	err := s.tx.InTx(ctx, func(tx Stores) error {
+		err := tx.Teams.Create(ctx, team.TeamName)
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return ErrTeamExists
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, m := range team.Members {
+			if err := tx.Users.Upsert(ctx, m.UserId, m.Username, team.TeamName, m.IsActive); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	slog.InfoContext(ctx, "Команда создана", "team_name", team.TeamName, "members", len(team.Members))
 
-	// Возвращаем в формате API
-	return &api.Team{
-		TeamName: name,
-		Members:  []api.TeamMember{},
-	}, nil
+	// Возвращаем то, что реально сохранено
+	return s.GetTeamByName(ctx, team.TeamName)
 }
 
 // GetTeamByName — получить команду по имени
 func (s *TeamService) GetTeamByName(ctx context.Context, teamName string) (*api.Team, error) {
 	// Получаем информацию о команде
-	teamMap, err := s.teamRepo.GetByName(ctx, teamName)
+	team, err := s.teamRepo.GetByName(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
 
-	if teamMap == nil {
-		return nil, errors.New("team not found")
+	if team == nil {
+		return nil, ErrTeamNotFound
 	}
 
 	// Получаем членов команды
-	members, err := s.userRepo.GetActiveMembers(ctx, teamName)
+	members, err := s.userRepo.GetByTeam(ctx, teamName)
 	if err != nil {
-		members = []map[string]interface{}{}
-	}
-
-	// Конвертируем в api.TeamMember
-	apiMembers := make([]api.TeamMember, 0, len(members))
-	for _, m := range members {
-		apiMembers = append(apiMembers, api.TeamMember{
-			UserId:   m["ID"].(string),
-			Username: m["Username"].(string),
-			IsActive: m["IsActive"].(bool),
-		})
+		return nil, err
 	}
 
 	return &api.Team{
-		TeamName: teamMap["Name"].(string),
-		Members:  apiMembers,
+		TeamName: team.Name,
+		Members:  toAPITeamMembers(members),
 	}, nil
 }
 
+// DeactivateTeam — деактивировать участников команды (всех, если userIDs == nil)
+// и переназначить их ревью в открытых PR на активных коллег по команде.
+// Если замены нет, слот ревьювера освобождается. Всё — в одной транзакции.
+// Нагрузка коллег читается один раз и дальше обновляется в памяти после каждой замены,
+// поэтому число запросов не зависит от количества пользователей и PR,
+// а least_loaded и weighted_random распределяют освободившиеся ревью по всей команде.
+func (s *TeamService) DeactivateTeam(ctx context.Context, teamName string, userIDs []string) (*api.TeamDeactivationResult, error) {
+	result := &api.TeamDeactivationResult{
+		TeamName:      teamName,
+		Reassignments: make([]api.ReviewerReassignment, 0),
+	}
+
+	err := s.tx.InTx(ctx, func(tx Stores) error {
+		// При повторе транзакции отчёт собирается заново
+		result.Reassignments = result.Reassignments[:0]
+
+		team, err := tx.Teams.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if team == nil {
+			return ErrTeamNotFound
+		}
+
+		deactivated, err := tx.Users.DeactivateMembers(ctx, teamName, userIDs)
+		if err != nil {
+			return err
+		}
+		result.DeactivatedUserIds = deactivated
+		if len(deactivated) == 0 {
+			return nil
+		}
+
+		// Блокируем затронутые открытые PR, чтобы их не смержили посреди замены
+		prs, err := tx.PRs.LockOpenReviewedBy(ctx, deactivated)
+		if err != nil {
+			return err
+		}
+		if len(prs) == 0 {
+			return nil
+		}
+
+		prIDs := make([]string, len(prs))
+		for i, pr := range prs {
+			prIDs[i] = pr.ID
+		}
+		reviewersByPR, err := tx.Reviewers.GetByPRs(ctx, prIDs)
+		if err != nil {
+			return err
+		}
+
+		// Активные участники уже после деактивации
+		members, err := tx.Users.GetActiveMembers(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		memberIDs := make([]string, len(members))
+		for i, m := range members {
+			memberIDs[i] = m.ID
+		}
+		counts, err := tx.Reviewers.OpenReviewCounts(ctx, memberIDs)
+		if err != nil {
+			return err
+		}
+		loads := loadSnapshot(counts)
+		selectCtx := withLoads(ctx, loads)
+
+		gone := make(map[string]bool, len(deactivated))
+		for _, id := range deactivated {
+			gone[id] = true
+		}
+
+		var swaps, removals []storage.ReviewerSwap
+		var events []domain.AssignmentEvent
+		actor := actorFrom(ctx)
+		for _, pr := range prs {
+			reviewers := reviewersByPR[pr.ID]
+			for _, oldID := range reviewers {
+				if !gone[oldID] {
+					continue
+				}
+
+				// Исключаем автора и тех, кто уже ревьюит этот PR
+				candidates := make([]string, 0, len(members))
+				for _, m := range members {
+					if m.ID != pr.AuthorID && !contains(reviewers, m.ID) {
+						candidates = append(candidates, m.ID)
+					}
+				}
+
+				selection, err := s.selectors.Select(selectCtx, teamName, candidates, 1)
+				if err != nil {
+					return err
+				}
+
+				change := api.ReviewerReassignment{PullRequestId: pr.ID, OldReviewerId: oldID}
+				event := domain.AssignmentEvent{
+					PRID: pr.ID, Type: domain.EventUnassigned, OldReviewerID: oldID,
+					Actor: actor, Reason: domain.ReasonDeactivation,
+				}
+				if len(selection.Picked) == 0 {
+					removals = append(removals, storage.ReviewerSwap{PRID: pr.ID, OldID: oldID})
+				} else {
+					newID := selection.Picked[0]
+					swaps = append(swaps, storage.ReviewerSwap{PRID: pr.ID, OldID: oldID, NewID: newID})
+					change.NewReviewerId = &newID
+					event.Type, event.NewReviewerID = domain.EventReassigned, newID
+					// Новый ревьювер не должен попасть на второй слот того же PR
+					reviewers = append(reviewers, newID)
+					loads[newID]++
+				}
+				result.Reassignments = append(result.Reassignments, change)
+				events = append(events, event)
+			}
+		}
+
+		if err := tx.Reviewers.ReplaceReviewers(ctx, swaps); err != nil {
+			return err
+		}
+		if err := tx.Reviewers.RemoveReviewers(ctx, removals); err != nil {
+			return err
+		}
+		return tx.Events.Append(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	freed := 0
+	for _, change := range result.Reassignments {
+		if change.NewReviewerId != nil {
+			s.metrics.ReviewerReassigned(domain.ReasonDeactivation)
+		} else {
+			s.metrics.NoCandidate(domain.ReasonDeactivation)
+			freed++
+		}
+	}
+	slog.InfoContext(ctx, "Участники команды деактивированы", "team_name", teamName,
+		"deactivated", len(result.DeactivatedUserIds),
+		"reassigned", len(result.Reassignments)-freed, "freed_slots", freed)
+	return result, nil
+}
+
 // ListTeams — получить все команды
 func (s *TeamService) ListTeams(ctx context.Context) ([]*api.Team, error) {
 	teams, err := s.teamRepo.List(ctx)
@@ -82,26 +235,15 @@ func (s *TeamService) ListTeams(ctx context.Context) ([]*api.Team, error) {
 
 	result := make([]*api.Team, 0, len(teams))
 	for _, t := range teams {
-		teamName := t["Name"].(string)
-
 		// Получаем членов каждой команды
-		members, err := s.userRepo.GetActiveMembers(ctx, teamName)
+		members, err := s.userRepo.GetByTeam(ctx, t.Name)
 		if err != nil {
-			members = []map[string]interface{}{}
-		}
-
-		apiMembers := make([]api.TeamMember, 0, len(members))
-		for _, m := range members {
-			apiMembers = append(apiMembers, api.TeamMember{
-				UserId:   m["ID"].(string),
-				Username: m["Username"].(string),
-				IsActive: m["IsActive"].(bool),
-			})
+			return nil, err
 		}
 
 		result = append(result, &api.Team{
-			TeamName: teamName,
-			Members:  apiMembers,
+			TeamName: t.Name,
+			Members:  toAPITeamMembers(members),
 		})
 	}
 
@@ -111,7 +253,7 @@ func (s *TeamService) ListTeams(ctx context.Context) ([]*api.Team, error) {
 // UpdateTeam — обновить название команды
 func (s *TeamService) UpdateTeam(ctx context.Context, oldTeamName string, newTeamName string) error {
 	if newTeamName == "" {
-		return errors.New("team name cannot be empty")
+		return Validation("team name cannot be empty")
 	}
 
 	return s.teamRepo.Update(ctx, oldTeamName, newTeamName)
@@ -0,0 +1,260 @@
+package service_test
+
+import (
+	"avito-2025/internal/api"
+	"avito-2025/internal/service"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// Освободившиеся ревью расходятся по наименее загруженным коллегам,
+// а не достаются одному: нагрузка учитывается после каждой замены
+func TestDeactivateTeamSpreadsLoad(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "leaving", "c1", "c2", "c3")
+
+	// c1 уже ревьюит два PR, у c2 и c3 ревью нет
+	f.openPR(t, "busy-1", "author", "c1")
+	f.openPR(t, "busy-2", "author", "c1")
+	for i := 1; i <= 4; i++ {
+		f.openPR(t, fmt.Sprintf("pr-%d", i), "author", "leaving")
+	}
+
+	result, err := f.teams.DeactivateTeam(ctx, "backend", []string{"leaving"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.DeactivatedUserIds) != 1 || result.DeactivatedUserIds[0] != "leaving" {
+		t.Fatalf("deactivated: %v", result.DeactivatedUserIds)
+	}
+	if len(result.Reassignments) != 4 {
+		t.Fatalf("got %d reassignments, want 4", len(result.Reassignments))
+	}
+
+	picked := make(map[string]int)
+	for _, r := range result.Reassignments {
+		if r.OldReviewerId != "leaving" || r.NewReviewerId == nil {
+			t.Fatalf("reassignment: %+v", r)
+		}
+		picked[*r.NewReviewerId]++
+
+		// Отчёт совпадает с сохранённым состоянием
+		if got := f.reviewers(t, r.PullRequestId); len(got) != 1 || got[0] != *r.NewReviewerId {
+			t.Errorf("%s reviewers: got %v, want [%s]", r.PullRequestId, got, *r.NewReviewerId)
+		}
+	}
+	if picked["c1"] != 0 || picked["c2"] != 2 || picked["c3"] != 2 {
+		t.Errorf("new reviews per colleague: %v, want c2 and c3 two each", picked)
+	}
+}
+
+// Без активных коллег слот ревьювера освобождается
+func TestDeactivateTeamFreesSlot(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "author", "r1", "r2")
+	f.openPR(t, "pr-1", "author", "r1", "r2")
+
+	result, err := f.teams.DeactivateTeam(ctx, "backend", []string{"r1", "r2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Reassignments) != 2 {
+		t.Fatalf("got %d reassignments, want 2", len(result.Reassignments))
+	}
+	for _, r := range result.Reassignments {
+		if r.NewReviewerId != nil {
+			t.Errorf("want a freed slot, got %+v", r)
+		}
+	}
+	if got := f.reviewers(t, "pr-1"); len(got) != 0 {
+		t.Errorf("reviewers left: %v", got)
+	}
+}
+
+// Команда из 200 человек, половина уходит; у каждого два открытых ревью
+func BenchmarkDeactivateTeam(b *testing.B) {
+	benchmarkDeactivateTeam(b, func() *fixture {
+		return newFixture(b, service.StrategyLeastLoaded)
+	})
+}
+
+// То же на Postgres. Цель из требований — меньше 100 мс на операцию.
+func BenchmarkDeactivateTeamPostgres(b *testing.B) {
+	db := testDB(b)
+	benchmarkDeactivateTeam(b, func() *fixture {
+		return newPostgresFixture(b, db, service.StrategyLeastLoaded)
+	})
+}
+
+func benchmarkDeactivateTeam(b *testing.B, newFixture func() *fixture) {
+	const size = 200
+	ctx := context.Background()
+
+	ids := make([]string, size)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("u%03d", i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		f := newFixture()
+		f.team(b, "big", ids...)
+		for j, author := range ids {
+			f.openPR(b, "pr-"+author, author, ids[(j+1)%size], ids[(j+2)%size])
+		}
+		b.StartTimer()
+
+		result, err := f.teams.DeactivateTeam(ctx, "big", ids[:size/2])
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(result.DeactivatedUserIds) != size/2 {
+			b.Fatalf("deactivated %d users", len(result.DeactivatedUserIds))
+		}
+	}
+}
+
+func TestDeactivateWholeTeam(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, service.StrategyLeastLoaded)
+	f.team(t, "backend", "b1", "b2")
+	f.team(t, "mobile", "m1", "m2", "m3")
+	f.openPR(t, "pr-1", "m1", "b1")
+	f.openPR(t, "pr-2", "m1", "m2")
+
+	// Без списка деактивируются все; ревьюверы из других команд не трогаются
+	result, err := f.teams.DeactivateTeam(ctx, "backend", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(sorted(result.DeactivatedUserIds)) != "[b1 b2]" {
+		t.Fatalf("deactivated: %v", result.DeactivatedUserIds)
+	}
+	if len(result.Reassignments) != 1 || result.Reassignments[0].NewReviewerId != nil {
+		t.Fatalf("reassignments: %+v", result.Reassignments)
+	}
+	if got := f.reviewers(t, "pr-2"); fmt.Sprint(got) != "[m2]" {
+		t.Fatalf("pr-2 reviewers: %v", got)
+	}
+
+	team, err := f.teams.GetTeamByName(ctx, "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range team.Members {
+		if m.IsActive {
+			t.Errorf("%s is still active", m.UserId)
+		}
+	}
+
+	// Повтор ничего не переназначает
+	again, err := f.teams.DeactivateTeam(ctx, "backend", nil)
+	if err != nil || len(again.Reassignments) != 0 {
+		t.Fatalf("second run: %+v, %v", again, err)
+	}
+
+	if _, err := f.teams.DeactivateTeam(ctx, "nope", nil); !errors.Is(err, service.ErrNotFound) {
+		t.Fatalf("unknown team: got %v", err)
+	}
+}
+
+// members — участники команды в виде "id:username:активен", по возрастанию id
+func members(team *api.Team) []string {
+	out := make([]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		out = append(out, fmt.Sprintf("%s:%s:%t", m.UserId, m.Username, m.IsActive))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestCreateTeam(t *testing.T) {
+	cases := []struct {
+		name     string
+		team     api.Team
+		want     error
+		members  []string // состав созданной команды
+		payments []string // состав уже существующей команды после вызова
+	}{
+		{
+			name: "new members are created",
+			team: api.Team{TeamName: "backend", Members: []api.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "u2", Username: "Bob", IsActive: false},
+			}},
+			members:  []string{"u1:Alice:true", "u2:Bob:false"},
+			payments: []string{"p1:name-p1:true", "p2:name-p2:true"},
+		},
+		{
+			name: "existing user moves and is updated",
+			team: api.Team{TeamName: "backend", Members: []api.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "p1", Username: "Paul", IsActive: false},
+			}},
+			members:  []string{"p1:Paul:false", "u1:Alice:true"},
+			payments: []string{"p2:name-p2:true"},
+		},
+		{
+			name:     "team without members",
+			team:     api.Team{TeamName: "backend"},
+			members:  []string{},
+			payments: []string{"p1:name-p1:true", "p2:name-p2:true"},
+		},
+		{
+			name: "existing team name",
+			team: api.Team{TeamName: "payments", Members: []api.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+				{UserId: "p1", Username: "Paul", IsActive: false},
+			}},
+			want:     service.ErrTeamExists,
+			payments: []string{"p1:name-p1:true", "p2:name-p2:true"},
+		},
+		{
+			name:     "empty name",
+			team:     api.Team{Members: []api.TeamMember{{UserId: "u1", Username: "Alice", IsActive: true}}},
+			want:     service.ErrValidation,
+			payments: []string{"p1:name-p1:true", "p2:name-p2:true"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t, service.StrategyLeastLoaded)
+			f.team(t, "payments", "p1", "p2")
+
+			got, err := f.teams.CreateTeam(ctx, c.team)
+			if !errors.Is(err, c.want) {
+				t.Fatalf("got %v, want %v", err, c.want)
+			}
+			if c.want == nil {
+				if got.TeamName != c.team.TeamName || fmt.Sprint(members(got)) != fmt.Sprint(c.members) {
+					t.Errorf("created: %s %v, want %v", got.TeamName, members(got), c.members)
+				}
+				stored, err := f.teams.GetTeamByName(ctx, c.team.TeamName)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if fmt.Sprint(members(stored)) != fmt.Sprint(c.members) {
+					t.Errorf("stored: %v, want %v", members(stored), c.members)
+				}
+			} else if user, _ := f.st.Users.GetByID(ctx, "u1"); user != nil {
+				// Ошибка откатывает всю операцию
+				t.Errorf("u1 saved after %v", err)
+			}
+
+			payments, err := f.teams.GetTeamByName(ctx, "payments")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(members(payments)) != fmt.Sprint(c.payments) {
+				t.Errorf("payments: %v, want %v", members(payments), c.payments)
+			}
+		})
+	}
+}
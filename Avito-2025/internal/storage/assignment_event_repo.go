@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"avito-2025/internal/domain"
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// AssignmentEventRepository — журнал событий назначения ревьюверов (только запись и чтение)
+type AssignmentEventRepository struct {
+	db DBTX
+}
+
+func NewAssignmentEventRepository(db DBTX) *AssignmentEventRepository {
+	return &AssignmentEventRepository{db: db}
+}
+
+// WithTx — копия репозитория, работающая внутри транзакции tx
+func (r *AssignmentEventRepository) WithTx(tx *sql.Tx) *AssignmentEventRepository {
+	return &AssignmentEventRepository{db: tx}
+}
+
+// Append — дописать события одним INSERT в порядке передачи.
+// Пустые OldReviewerID/NewReviewerID сохраняются как NULL.
+func (r *AssignmentEventRepository) Append(ctx context.Context, events ...domain.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	n := len(events)
+	prIDs, types := make([]string, n), make([]string, n)
+	oldIDs, newIDs := make([]string, n), make([]string, n)
+	actors, reasons := make([]string, n), make([]string, n)
+	for i, e := range events {
+		prIDs[i], types[i] = e.PRID, e.Type
+		oldIDs[i], newIDs[i] = e.OldReviewerID, e.NewReviewerID
+		actors[i], reasons[i] = e.Actor, e.Reason
+	}
+
+	query := `INSERT INTO review_assignment_events 
+	              (pr_id, event_type, old_reviewer_id, new_reviewer_id, actor, reason, created_at) 
+	          SELECT v.pr_id, v.event_type, NULLIF(v.old_id, ''), NULLIF(v.new_id, ''), v.actor, v.reason, NOW() 
+	          FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::varchar[], $5::varchar[], $6::varchar[]) 
+	              WITH ORDINALITY AS v(pr_id, event_type, old_id, new_id, actor, reason, ord) 
+	          ORDER BY v.ord`
+
+	_, err := r.db.ExecContext(ctx, query,
+		pq.Array(prIDs), pq.Array(types), pq.Array(oldIDs), pq.Array(newIDs), pq.Array(actors), pq.Array(reasons))
+	return translate(err)
+}
+
+// ListByPR — история PR в хронологическом порядке
+func (r *AssignmentEventRepository) ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	query := `SELECT id, pr_id, event_type, COALESCE(old_reviewer_id, ''), COALESCE(new_reviewer_id, ''), 
+	                 actor, reason, created_at 
+	          FROM review_assignment_events WHERE pr_id = $1 ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.AssignmentEvent, 0)
+	for rows.Next() {
+		var e domain.AssignmentEvent
+		if err := rows.Scan(&e.ID, &e.PRID, &e.Type, &e.OldReviewerID, &e.NewReviewerID,
+			&e.Actor, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -1,11 +1,81 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
+// DBTX — общий интерфейс *sql.DB и *sql.Tx, чтобы репозитории работали и внутри транзакции
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// ErrAlreadyExists — запись с таким уникальным ключом уже есть
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrNotFound — изменение ссылается на запись, которой нет
+var ErrNotFound = errors.New("not found")
+
+// ErrReferenced — запись нельзя удалить: на неё ссылаются другие записи
+var ErrReferenced = errors.New("referenced by other records")
+
+// translate — ошибки Postgres в ошибки хранилища: unique_violation -> ErrAlreadyExists,
+// foreign_key_violation -> ErrNotFound. Исходная ошибка остаётся в цепочке.
+func translate(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+	switch pqErr.Code {
+	case "23505":
+		return fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+	case "23503":
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
+// translateDelete — при удалении нарушение внешнего ключа значит, что на запись ссылаются
+func translateDelete(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return fmt.Errorf("%w: %w", ErrReferenced, err)
+	}
+	return err
+}
+
+// expectInserted — для INSERT ... ON CONFLICT DO NOTHING: ноль строк значит дубликат
+func expectInserted(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAlreadyExists
+	}
+	return nil
+}
+
+// rowScanner — общий интерфейс *sql.Row и *sql.Rows для scan-функций
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// timeOrZero — NULL-колонка времени превращается в нулевое time.Time
+func timeOrZero(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
 func NewDB(dataSourceName string) (*sql.DB, error) {
 	db, err := sql.Open("postgres", dataSourceName)
 	if err != nil {
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"context"
+)
+
+// EventStore — журнал назначений; записи только дописываются
+type EventStore struct {
+	db access
+}
+
+// Append — дописать события в порядке передачи
+func (r *EventStore) Append(_ context.Context, events ...domain.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.write(func(s *state) error {
+		t := now()
+		for _, e := range events {
+			s.nextEventID++
+			e.ID, e.CreatedAt = s.nextEventID, t
+			s.events = append(s.events, e)
+		}
+		return nil
+	})
+}
+
+// ListByPR — история PR в хронологическом порядке
+func (r *EventStore) ListByPR(_ context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	events := make([]domain.AssignmentEvent, 0)
+	r.db.read(func(s *state) {
+		for _, e := range s.events {
+			if e.PRID == prID {
+				events = append(events, e)
+			}
+		}
+	})
+	return events, nil
+}
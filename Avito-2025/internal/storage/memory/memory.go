@@ -0,0 +1,149 @@
+// Package memory — хранилище в памяти процесса с теми же контрактами, что и репозитории Postgres.
+// Подходит для демо, локальной разработки и быстрых тестов; данные теряются при перезапуске.
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/service"
+	"context"
+	"sync"
+	"time"
+)
+
+// state — все данные хранилища. Уникальность ключей повторяет ограничения схемы:
+// teams.name, users.user_id, pull_requests.pull_request_id, (pr_id, reviewer_id).
+type state struct {
+	teams     map[string]domain.Team
+	users     map[string]domain.User
+	prs       map[string]domain.PullRequest
+	reviewers []domain.PRReviewer
+	events    []domain.AssignmentEvent
+
+	nextTeamID     int
+	nextReviewerID int
+	nextEventID    int64
+}
+
+func newState() *state {
+	return &state{
+		teams: make(map[string]domain.Team),
+		users: make(map[string]domain.User),
+		prs:   make(map[string]domain.PullRequest),
+	}
+}
+
+// clone — копия для транзакции; записи хранятся по значению, поэтому достаточно копировать контейнеры
+func (s *state) clone() *state {
+	c := *s
+	c.teams = make(map[string]domain.Team, len(s.teams))
+	for k, v := range s.teams {
+		c.teams[k] = v
+	}
+	c.users = make(map[string]domain.User, len(s.users))
+	for k, v := range s.users {
+		c.users[k] = v
+	}
+	c.prs = make(map[string]domain.PullRequest, len(s.prs))
+	for k, v := range s.prs {
+		c.prs[k] = v
+	}
+	c.reviewers = append([]domain.PRReviewer(nil), s.reviewers...)
+	c.events = append([]domain.AssignmentEvent(nil), s.events...)
+	return &c
+}
+
+// access — доступ к состоянию: с блокировкой вне транзакции и без неё внутри
+type access interface {
+	read(fn func(s *state))
+	write(fn func(s *state) error) error
+}
+
+// Store — хранилище в памяти. Операции вне транзакции атомарны по отдельности,
+// транзакция работает с копией состояния и подменяет его при commit.
+// Чтение вне транзакции видит последнее закоммиченное состояние и не ждёт
+// транзакций — так работают GET-запросы, пока идёт запись.
+type Store struct {
+	// writeMu сериализует транзакции и записи вне их
+	writeMu sync.Mutex
+	mu      sync.RWMutex
+	st      *state
+}
+
+func New() *Store {
+	return &Store{st: newState()}
+}
+
+func (m *Store) read(fn func(s *state)) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	fn(m.st)
+}
+
+func (m *Store) write(fn func(s *state) error) error {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return fn(m.st)
+}
+
+// txState — состояние внутри транзакции; копия принадлежит только InTx
+type txState struct {
+	st *state
+}
+
+func (t txState) read(fn func(s *state)) { fn(t.st) }
+
+func (t txState) write(fn func(s *state) error) error { return fn(t.st) }
+
+// Stores — хранилища вне транзакции
+func (m *Store) Stores() service.Stores {
+	return storesOver(m)
+}
+
+// Stats — агрегаты для статистики назначений
+func (m *Store) Stats() service.StatsStore {
+	return &StatsStore{db: m}
+}
+
+// InTx — выполнить fn над копией состояния; копия заменяет состояние только при успехе.
+// Транзакции сериализованы, поэтому конфликтов и повторов не бывает.
+func (m *Store) InTx(ctx context.Context, fn func(tx service.Stores) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	m.mu.RLock()
+	work := m.st.clone()
+	m.mu.RUnlock()
+
+	if err := fn(storesOver(txState{st: work})); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.st = work
+	m.mu.Unlock()
+	return nil
+}
+
+func storesOver(db access) service.Stores {
+	return service.Stores{
+		Users:     &UserStore{db: db},
+		Teams:     &TeamStore{db: db},
+		PRs:       &PRStore{db: db},
+		Reviewers: &ReviewerStore{db: db},
+		Events:    &EventStore{db: db},
+	}
+}
+
+// now — время записи; в UTC, как NOW() в Postgres с настройками по умолчанию
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+var _ service.TxRunner = (*Store)(nil)
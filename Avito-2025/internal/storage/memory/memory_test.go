@@ -0,0 +1,14 @@
+package memory_test
+
+import (
+	"avito-2025/internal/storage/memory"
+	"avito-2025/internal/storage/storetest"
+	"testing"
+)
+
+func TestMemoryStoreContract(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) storetest.Backend {
+		store := memory.New()
+		return storetest.Backend{Stores: store.Stores(), Tx: store, Stats: store.Stats()}
+	})
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"sort"
+)
+
+type PRStore struct {
+	db access
+}
+
+// Create — создать PR; дубликат ID — storage.ErrAlreadyExists, неизвестный автор — storage.ErrNotFound
+func (r *PRStore) Create(_ context.Context, prID string, prName string, authorID string, status string) error {
+	return r.db.write(func(s *state) error {
+		if _, ok := s.prs[prID]; ok {
+			return storage.ErrAlreadyExists
+		}
+		if _, ok := s.users[authorID]; !ok {
+			return storage.ErrNotFound
+		}
+		t := now()
+		s.prs[prID] = domain.PullRequest{
+			ID: prID, Name: prName, AuthorID: authorID, Status: status,
+			CreatedAt: t, UpdatedAt: t,
+		}
+		return nil
+	})
+}
+
+// GetByID — PR по ID или nil
+func (r *PRStore) GetByID(_ context.Context, prID string) (*domain.PullRequest, error) {
+	var result *domain.PullRequest
+	r.db.read(func(s *state) {
+		if pr, ok := s.prs[prID]; ok {
+			result = &pr
+		}
+	})
+	return result, nil
+}
+
+// GetForUpdate — то же, что GetByID: транзакции в памяти и так эксклюзивны
+func (r *PRStore) GetForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return r.GetByID(ctx, prID)
+}
+
+// MarkMerged — перевести OPEN PR в MERGED; false, если PR нет или он уже смержен
+func (r *PRStore) MarkMerged(_ context.Context, prID string) (bool, error) {
+	merged := false
+	err := r.db.write(func(s *state) error {
+		pr, ok := s.prs[prID]
+		if !ok || pr.Status != domain.PRStatusOpen {
+			return nil
+		}
+
+		t := now()
+		pr.Status, pr.MergedAt, pr.UpdatedAt = domain.PRStatusMerged, &t, t
+		s.prs[prID] = pr
+		merged = true
+		return nil
+	})
+	return merged, err
+}
+
+// LockOpenReviewedBy — открытые PR, где ревьювером назначен кто-то из reviewerIDs
+func (r *PRStore) LockOpenReviewedBy(_ context.Context, reviewerIDs []string) ([]domain.PullRequest, error) {
+	wanted := make(map[string]bool, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		wanted[id] = true
+	}
+
+	var prs []domain.PullRequest
+	r.db.read(func(s *state) {
+		seen := make(map[string]bool)
+		for _, rv := range s.reviewers {
+			pr, ok := s.prs[rv.PRID]
+			if !ok || seen[pr.ID] || !wanted[rv.ReviewerID] || pr.Status != domain.PRStatusOpen {
+				continue
+			}
+			seen[pr.ID] = true
+			prs = append(prs, pr)
+		}
+	})
+
+	sort.Slice(prs, func(i, j int) bool { return prs[i].ID < prs[j].ID })
+	return prs, nil
+}
+
+// ListReviewedBy — PR, где пользователь ревьювер, по возрастанию ID
+func (r *PRStore) ListReviewedBy(_ context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	var prs []domain.PullRequest
+	r.db.read(func(s *state) {
+		for _, rv := range s.reviewers {
+			if pr, ok := s.prs[rv.PRID]; ok && rv.ReviewerID == reviewerID {
+				prs = append(prs, pr)
+			}
+		}
+	})
+
+	sort.Slice(prs, func(i, j int) bool { return prs[i].ID < prs[j].ID })
+	return prs, nil
+}
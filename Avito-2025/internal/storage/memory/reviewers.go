@@ -0,0 +1,194 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"sort"
+)
+
+type ReviewerStore struct {
+	db access
+}
+
+// findReviewer — индекс назначения (prID, reviewerID) или -1
+func (s *state) findReviewer(prID string, reviewerID string) int {
+	for i, rv := range s.reviewers {
+		if rv.PRID == prID && rv.ReviewerID == reviewerID {
+			return i
+		}
+	}
+	return -1
+}
+
+// AssignReviewer — назначить ревьювера; повторное назначение — storage.ErrAlreadyExists,
+// как INSERT ... ON CONFLICT (pr_id, reviewer_id) DO NOTHING; неизвестный PR или пользователь — storage.ErrNotFound
+func (r *ReviewerStore) AssignReviewer(_ context.Context, prID string, reviewerID string) error {
+	return r.db.write(func(s *state) error {
+		if s.findReviewer(prID, reviewerID) >= 0 {
+			return storage.ErrAlreadyExists
+		}
+		if _, ok := s.prs[prID]; !ok {
+			return storage.ErrNotFound
+		}
+		if _, ok := s.users[reviewerID]; !ok {
+			return storage.ErrNotFound
+		}
+		s.nextReviewerID++
+		s.reviewers = append(s.reviewers, domain.PRReviewer{
+			ID: s.nextReviewerID, PRID: prID, ReviewerID: reviewerID, AssignedAt: now(),
+		})
+		return nil
+	})
+}
+
+// RemoveReviewer — снять ревьювера; false, если он не был назначен
+func (r *ReviewerStore) RemoveReviewer(_ context.Context, prID string, reviewerID string) (bool, error) {
+	removed := false
+	err := r.db.write(func(s *state) error {
+		removed = s.removeReviewer(prID, reviewerID)
+		return nil
+	})
+	return removed, err
+}
+
+func (s *state) removeReviewer(prID string, reviewerID string) bool {
+	i := s.findReviewer(prID, reviewerID)
+	if i < 0 {
+		return false
+	}
+	s.reviewers = append(s.reviewers[:i], s.reviewers[i+1:]...)
+	return true
+}
+
+// ReplaceReviewer — заменить oldID на newID; false, если oldID не был назначен
+func (r *ReviewerStore) ReplaceReviewer(_ context.Context, prID string, oldID string, newID string) (bool, error) {
+	replaced := false
+	err := r.db.write(func(s *state) error {
+		var err error
+		replaced, err = s.replaceReviewer(prID, oldID, newID)
+		return err
+	})
+	return replaced, err
+}
+
+func (s *state) replaceReviewer(prID string, oldID string, newID string) (bool, error) {
+	i := s.findReviewer(prID, oldID)
+	if i < 0 {
+		return false, nil
+	}
+	// Нарушение UNIQUE (pr_id, reviewer_id)
+	if oldID != newID && s.findReviewer(prID, newID) >= 0 {
+		return false, storage.ErrAlreadyExists
+	}
+	s.reviewers[i].ReviewerID = newID
+	s.reviewers[i].AssignedAt = now()
+	return true, nil
+}
+
+// ReplaceReviewers — пачка замен; пропущенные (не назначенные) игнорируются, как в UPDATE ... FROM
+func (r *ReviewerStore) ReplaceReviewers(_ context.Context, swaps []storage.ReviewerSwap) error {
+	if len(swaps) == 0 {
+		return nil
+	}
+	return r.db.write(func(s *state) error {
+		// При нарушении уникальности откатываем уже применённые замены
+		work := append([]domain.PRReviewer(nil), s.reviewers...)
+		for _, sw := range swaps {
+			if _, err := s.replaceReviewer(sw.PRID, sw.OldID, sw.NewID); err != nil {
+				s.reviewers = work
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveReviewers — снять пачку ревьюверов (NewID не используется)
+func (r *ReviewerStore) RemoveReviewers(_ context.Context, slots []storage.ReviewerSwap) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	return r.db.write(func(s *state) error {
+		for _, sl := range slots {
+			s.removeReviewer(sl.PRID, sl.OldID)
+		}
+		return nil
+	})
+}
+
+// sortedReviewers — назначения в порядке assigned_at, id
+func sortedReviewers(list []domain.PRReviewer) []domain.PRReviewer {
+	out := append([]domain.PRReviewer(nil), list...)
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].AssignedAt.Equal(out[j].AssignedAt) {
+			return out[i].AssignedAt.Before(out[j].AssignedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// GetByPR — ревьюверы PR в порядке назначения
+func (r *ReviewerStore) GetByPR(_ context.Context, prID string) ([]string, error) {
+	var ids []string
+	r.db.read(func(s *state) {
+		for _, rv := range sortedReviewers(s.reviewers) {
+			if rv.PRID == prID {
+				ids = append(ids, rv.ReviewerID)
+			}
+		}
+	})
+	return ids, nil
+}
+
+// GetByPRs — ревьюверы нескольких PR: pr_id -> reviewer_id
+func (r *ReviewerStore) GetByPRs(_ context.Context, prIDs []string) (map[string][]string, error) {
+	wanted := make(map[string]bool, len(prIDs))
+	for _, id := range prIDs {
+		wanted[id] = true
+	}
+
+	result := make(map[string][]string, len(prIDs))
+	r.db.read(func(s *state) {
+		for _, rv := range sortedReviewers(s.reviewers) {
+			if wanted[rv.PRID] {
+				result[rv.PRID] = append(result[rv.PRID], rv.ReviewerID)
+			}
+		}
+	})
+	return result, nil
+}
+
+// GetPRsByReviewer — PR, где пользователь ревьювер, по возрастанию ID
+func (r *ReviewerStore) GetPRsByReviewer(_ context.Context, reviewerID string) ([]string, error) {
+	var ids []string
+	r.db.read(func(s *state) {
+		for _, rv := range s.reviewers {
+			if rv.ReviewerID == reviewerID {
+				ids = append(ids, rv.PRID)
+			}
+		}
+	})
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// OpenReviewCounts — число OPEN PR на ревью; пользователи без нагрузки в результат не попадают
+func (r *ReviewerStore) OpenReviewCounts(_ context.Context, userIDs []string) (map[string]int, error) {
+	wanted := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+
+	counts := make(map[string]int, len(userIDs))
+	r.db.read(func(s *state) {
+		for _, rv := range s.reviewers {
+			if wanted[rv.ReviewerID] && s.prs[rv.PRID].Status == domain.PRStatusOpen {
+				counts[rv.ReviewerID]++
+			}
+		}
+	})
+	return counts, nil
+}
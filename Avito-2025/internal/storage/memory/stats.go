@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"context"
+	"sort"
+	"time"
+)
+
+// StatsStore — агрегаты статистики с той же семантикой, что и запросы storage.StatsRepository
+type StatsStore struct {
+	db access
+}
+
+// inPeriod — t попадает в [From, To)
+func inPeriod(f domain.StatsFilter, t time.Time) bool {
+	if f.From != nil && t.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && !t.Before(*f.To) {
+		return false
+	}
+	return true
+}
+
+func isRemoval(e domain.AssignmentEvent) bool {
+	return e.Type == domain.EventReassigned || e.Type == domain.EventUnassigned
+}
+
+func isAssignment(e domain.AssignmentEvent) bool {
+	return e.Type == domain.EventAssigned || e.Type == domain.EventReassigned
+}
+
+// UserStats — нагрузка по каждому пользователю. Назначения и снятия — по журналу событий,
+// open_reviews — текущая нагрузка без учёта периода
+func (r *StatsStore) UserStats(_ context.Context, f domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	stats := make([]domain.UserAssignmentStats, 0)
+	r.db.read(func(s *state) {
+		byUser := make(map[string]*domain.UserAssignmentStats)
+		for _, u := range s.users {
+			if f.TeamName != "" && u.TeamName != f.TeamName {
+				continue
+			}
+			byUser[u.ID] = &domain.UserAssignmentStats{UserID: u.ID, Username: u.Username, TeamName: u.TeamName}
+		}
+
+		for _, rv := range s.reviewers {
+			st, ok := byUser[rv.ReviewerID]
+			if !ok {
+				continue
+			}
+			switch s.prs[rv.PRID].Status {
+			case domain.PRStatusOpen:
+				st.OpenReviews++
+			case domain.PRStatusMerged:
+				if inPeriod(f, rv.AssignedAt) {
+					st.MergedReviewed++
+				}
+			}
+		}
+
+		for _, e := range s.events {
+			if !inPeriod(f, e.CreatedAt) {
+				continue
+			}
+			if st, ok := byUser[e.NewReviewerID]; ok && isAssignment(e) {
+				st.TotalAssignments++
+			}
+			if st, ok := byUser[e.OldReviewerID]; ok && isRemoval(e) {
+				st.ReassignedAway++
+			}
+		}
+
+		for _, st := range byUser {
+			stats = append(stats, *st)
+		}
+	})
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].UserID < stats[j].UserID })
+	return stats, nil
+}
+
+// PRStats — переназначения и время до мержа по каждому PR
+func (r *StatsStore) PRStats(_ context.Context, f domain.StatsFilter) ([]domain.PRAssignmentStats, error) {
+	stats := make([]domain.PRAssignmentStats, 0)
+	r.db.read(func(s *state) {
+		removals := make(map[string]int)
+		for _, e := range s.events {
+			if isRemoval(e) {
+				removals[e.PRID]++
+			}
+		}
+
+		for _, pr := range s.prs {
+			author, ok := s.users[pr.AuthorID]
+			if !ok || (f.TeamName != "" && author.TeamName != f.TeamName) || !inPeriod(f, pr.CreatedAt) {
+				continue
+			}
+
+			st := domain.PRAssignmentStats{
+				PRID: pr.ID, Name: pr.Name, TeamName: author.TeamName,
+				Status: pr.Status, Reassignments: removals[pr.ID],
+			}
+			if pr.MergedAt != nil {
+				d := pr.MergedAt.Sub(pr.CreatedAt)
+				st.TimeToMerge = &d
+			}
+			stats = append(stats, st)
+		}
+	})
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].PRID < stats[j].PRID })
+	return stats, nil
+}
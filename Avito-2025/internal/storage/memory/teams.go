@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"fmt"
+	"sort"
+)
+
+type TeamStore struct {
+	db access
+}
+
+// Create — создать команду; дубликат имени — storage.ErrAlreadyExists
+func (r *TeamStore) Create(_ context.Context, name string) error {
+	return r.db.write(func(s *state) error {
+		if _, ok := s.teams[name]; ok {
+			return storage.ErrAlreadyExists
+		}
+		s.nextTeamID++
+		s.teams[name] = domain.Team{ID: s.nextTeamID, Name: name, CreatedAt: now()}
+		return nil
+	})
+}
+
+// GetByName — команда по имени или nil
+func (r *TeamStore) GetByName(_ context.Context, name string) (*domain.Team, error) {
+	var result *domain.Team
+	r.db.read(func(s *state) {
+		if t, ok := s.teams[name]; ok {
+			result = &t
+		}
+	})
+	return result, nil
+}
+
+// List — все команды в порядке создания
+func (r *TeamStore) List(_ context.Context) ([]domain.Team, error) {
+	var teams []domain.Team
+	r.db.read(func(s *state) {
+		for _, t := range s.teams {
+			teams = append(teams, t)
+		}
+	})
+
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	return teams, nil
+}
+
+// Update — переименовать команду; участники переходят вместе с ней (ON UPDATE CASCADE)
+func (r *TeamStore) Update(_ context.Context, oldName string, newName string) error {
+	return r.db.write(func(s *state) error {
+		t, ok := s.teams[oldName]
+		if !ok || oldName == newName {
+			return nil
+		}
+		if _, taken := s.teams[newName]; taken {
+			return storage.ErrAlreadyExists
+		}
+
+		delete(s.teams, oldName)
+		t.Name = newName
+		s.teams[newName] = t
+
+		for id, u := range s.users {
+			if u.TeamName == oldName {
+				u.TeamName = newName
+				s.users[id] = u
+			}
+		}
+		return nil
+	})
+}
+
+// Delete — удалить команду вместе с участниками (ON DELETE CASCADE). Как и внешние ключи
+// pull_requests.author_id и pr_reviewers.reviewer_id, не даёт удалить участника,
+// который автор или ревьювер PR: тогда storage.ErrReferenced и ничего не меняется.
+func (r *TeamStore) Delete(_ context.Context, name string) error {
+	return r.db.write(func(s *state) error {
+		members := make(map[string]bool)
+		for id, u := range s.users {
+			if u.TeamName == name {
+				members[id] = true
+			}
+		}
+		for _, pr := range s.prs {
+			if members[pr.AuthorID] {
+				return fmt.Errorf("%w: user %s is the author of %s", storage.ErrReferenced, pr.AuthorID, pr.ID)
+			}
+		}
+		for _, rv := range s.reviewers {
+			if members[rv.ReviewerID] {
+				return fmt.Errorf("%w: user %s reviews %s", storage.ErrReferenced, rv.ReviewerID, rv.PRID)
+			}
+		}
+
+		delete(s.teams, name)
+		for id := range members {
+			delete(s.users, id)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"sort"
+)
+
+type UserStore struct {
+	db access
+}
+
+// GetByID — пользователь по ID или nil
+func (r *UserStore) GetByID(_ context.Context, userID string) (*domain.User, error) {
+	var result *domain.User
+	r.db.read(func(s *state) {
+		if u, ok := s.users[userID]; ok {
+			result = &u
+		}
+	})
+	return result, nil
+}
+
+// Upsert — создать пользователя или обновить имя, команду и активность; неизвестная команда — storage.ErrNotFound
+func (r *UserStore) Upsert(_ context.Context, userID string, username string, teamName string, isActive bool) error {
+	return r.db.write(func(s *state) error {
+		if _, ok := s.teams[teamName]; !ok {
+			return storage.ErrNotFound
+		}
+		u, ok := s.users[userID]
+		if !ok {
+			u = domain.User{ID: userID, CreatedAt: now()}
+		}
+		u.Username, u.TeamName, u.IsActive = username, teamName, isActive
+		u.UpdatedAt = now()
+		s.users[userID] = u
+		return nil
+	})
+}
+
+// Update — обновить имя и активность; отсутствующий пользователь не ошибка, как UPDATE без строк
+func (r *UserStore) Update(_ context.Context, userID string, username string, isActive bool) error {
+	return r.db.write(func(s *state) error {
+		if u, ok := s.users[userID]; ok {
+			u.Username, u.IsActive, u.UpdatedAt = username, isActive, now()
+			s.users[userID] = u
+		}
+		return nil
+	})
+}
+
+// GetByTeam — все участники команды по возрастанию user_id
+func (r *UserStore) GetByTeam(_ context.Context, teamName string) ([]domain.User, error) {
+	return r.filter(func(u domain.User) bool { return u.TeamName == teamName }), nil
+}
+
+// GetActiveMembers — активные участники команды
+func (r *UserStore) GetActiveMembers(_ context.Context, teamName string) ([]domain.User, error) {
+	return r.filter(func(u domain.User) bool { return u.TeamName == teamName && u.IsActive }), nil
+}
+
+// DeactivateMembers — деактивировать участников команды (всех, если userIDs == nil)
+func (r *UserStore) DeactivateMembers(_ context.Context, teamName string, userIDs []string) ([]string, error) {
+	var wanted map[string]bool
+	if userIDs != nil {
+		wanted = make(map[string]bool, len(userIDs))
+		for _, id := range userIDs {
+			wanted[id] = true
+		}
+	}
+
+	ids := make([]string, 0)
+	err := r.db.write(func(s *state) error {
+		for id, u := range s.users {
+			if u.TeamName != teamName || (wanted != nil && !wanted[id]) {
+				continue
+			}
+			u.IsActive, u.UpdatedAt = false, now()
+			s.users[id] = u
+			ids = append(ids, id)
+		}
+		return nil
+	})
+
+	sort.Strings(ids)
+	return ids, err
+}
+
+func (r *UserStore) filter(keep func(u domain.User) bool) []domain.User {
+	var users []domain.User
+	r.db.read(func(s *state) {
+		for _, u := range s.users {
+			if keep(u) {
+				users = append(users, u)
+			}
+		}
+	})
+
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
@@ -1,51 +1,79 @@
 package storage
 
 import (
+	"avito-2025/internal/domain"
 	"context"
 	"database/sql"
-	"strconv"
+
+	"github.com/lib/pq"
 )
 
 type PRRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewPRRepository(db *sql.DB) *PRRepository {
+func NewPRRepository(db DBTX) *PRRepository {
 	return &PRRepository{db: db}
 }
 
-// Create — создать PR
-func (r *PRRepository) Create(ctx context.Context, prName string, authorID string, status string) (string, error) {
-	var id int
-	query := `INSERT INTO pull_requests (name, author_id, status, created_at) 
-	          VALUES ($1, $2, $3, NOW()) 
-	          RETURNING id`
+// WithTx — копия репозитория, работающая внутри транзакции tx
+func (r *PRRepository) WithTx(tx *sql.Tx) *PRRepository {
+	return &PRRepository{db: tx}
+}
+
+const prColumns = `pull_request_id, name, author_id, status, created_at, updated_at, merged_at`
+
+// scanPR — прочитать строку pull_requests в domain.PullRequest
+func scanPR(row rowScanner) (domain.PullRequest, error) {
+	var pr domain.PullRequest
+	var createdAt, updatedAt, mergedAt sql.NullTime
+
+	if err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &createdAt, &updatedAt, &mergedAt); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	pr.CreatedAt = timeOrZero(createdAt)
+	pr.UpdatedAt = timeOrZero(updatedAt)
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+	return pr, nil
+}
 
-	err := r.db.QueryRowContext(ctx, query, prName, authorID, status).Scan(&id)
+// Create — создать PR с внешним ID, выбранным клиентом.
+// Если PR с таким ID уже есть, возвращает ErrAlreadyExists.
+func (r *PRRepository) Create(ctx context.Context, prID string, prName string, authorID string, status string) error {
+	query := `INSERT INTO pull_requests (pull_request_id, name, author_id, status, created_at) 
+	          VALUES ($1, $2, $3, $4, NOW()) 
+	          ON CONFLICT (pull_request_id) DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, query, prID, prName, authorID, status)
 	if err != nil {
-		return "", err
+		return translate(err)
 	}
-	return strconv.Itoa(id), nil
+	return expectInserted(res)
 }
 
-// GetByID — получить PR по string ID (конвертирует строку в число для SQL!)
-func (r *PRRepository) GetByID(ctx context.Context, prID string) (map[string]interface{}, error) {
-	// ✅ ВАЖНО: Конвертируем string ID в int для SQL запроса
-	idInt, err := strconv.Atoi(prID)
+// GetByID — получить PR по внешнему string ID
+func (r *PRRepository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE pull_request_id = $1`
+
+	pr, err := scanPR(r.db.QueryRowContext(ctx, query, prID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	var id int
-	var name, authorID, status string
-	var createdAt, updatedAt interface{}
-
-	query := `SELECT id, name, author_id, status, created_at, updated_at 
-	          FROM pull_requests WHERE id = $1`
+	return &pr, nil
+}
 
-	err = r.db.QueryRowContext(ctx, query, idInt).
-		Scan(&id, &name, &authorID, &status, &createdAt, &updatedAt)
+// GetForUpdate — получить PR и заблокировать строку до конца транзакции
+func (r *PRRepository) GetForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE`
 
+	pr, err := scanPR(r.db.QueryRowContext(ctx, query, prID))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -53,138 +81,98 @@ func (r *PRRepository) GetByID(ctx context.Context, prID string) (map[string]int
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"ID":       strconv.Itoa(id),
-		"Name":     name,
-		"AuthorID": authorID,
-		"Status":   status,
-	}, nil
+	return &pr, nil
 }
 
 // UpdateStatus — обновить статус PR
 func (r *PRRepository) UpdateStatus(ctx context.Context, prID string, status string) error {
-	// ✅ ВАЖНО: Конвертируем string ID в int для SQL запроса
-	idInt, err := strconv.Atoi(prID)
+	query := `UPDATE pull_requests SET status = $1, updated_at = NOW() WHERE pull_request_id = $2`
+	_, err := r.db.ExecContext(ctx, query, status, prID)
+	return err
+}
+
+// MarkMerged — перевести PR из OPEN в MERGED одним условным UPDATE.
+// Возвращает true только для запроса, который выполнил переход; merged_at ставится один раз.
+func (r *PRRepository) MarkMerged(ctx context.Context, prID string) (bool, error) {
+	query := `UPDATE pull_requests 
+	          SET status = $2, merged_at = NOW(), updated_at = NOW() 
+	          WHERE pull_request_id = $1 AND status = $3`
+
+	res, err := r.db.ExecContext(ctx, query, prID, domain.PRStatusMerged, domain.PRStatusOpen)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	query := `UPDATE pull_requests SET status = $1, updated_at = NOW() WHERE id = $2`
-	_, err = r.db.ExecContext(ctx, query, status, idInt)
-	return err
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
 }
 
 // Delete — удалить PR
 func (r *PRRepository) Delete(ctx context.Context, prID string) error {
-	idInt, err := strconv.Atoi(prID)
-	if err != nil {
-		return err
-	}
-
-	query := `DELETE FROM pull_requests WHERE id = $1`
-	_, err = r.db.ExecContext(ctx, query, idInt)
+	query := `DELETE FROM pull_requests WHERE pull_request_id = $1`
+	_, err := r.db.ExecContext(ctx, query, prID)
 	return err
 }
 
 // List — получить все PR
-func (r *PRRepository) List(ctx context.Context) ([]map[string]interface{}, error) {
-	query := `SELECT id, name, author_id, status, created_at, updated_at 
-	          FROM pull_requests ORDER BY id`
-
-	rows, err := r.db.QueryContext(ctx, query)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var prs []map[string]interface{}
-	for rows.Next() {
-		var id int
-		var name, authorID, status string
-		var createdAt, updatedAt interface{}
-
-		if err := rows.Scan(&id, &name, &authorID, &status, &createdAt, &updatedAt); err != nil {
-			return nil, err
-		}
-
-		prs = append(prs, map[string]interface{}{
-			"ID":       strconv.Itoa(id),
-			"Name":     name,
-			"AuthorID": authorID,
-			"Status":   status,
-		})
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return prs, nil
+func (r *PRRepository) List(ctx context.Context) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests ORDER BY id`
+	return r.queryPRs(ctx, query)
 }
 
 // GetByAuthorID — получить все PR автора
-func (r *PRRepository) GetByAuthorID(ctx context.Context, authorID string) ([]map[string]interface{}, error) {
-	query := `SELECT id, name, author_id, status, created_at, updated_at 
-	          FROM pull_requests WHERE author_id = $1 ORDER BY id`
-
-	rows, err := r.db.QueryContext(ctx, query, authorID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var prs []map[string]interface{}
-	for rows.Next() {
-		var id int
-		var name, authorID, status string
-		var createdAt, updatedAt interface{}
-
-		if err := rows.Scan(&id, &name, &authorID, &status, &createdAt, &updatedAt); err != nil {
-			return nil, err
-		}
-
-		prs = append(prs, map[string]interface{}{
-			"ID":       strconv.Itoa(id),
-			"Name":     name,
-			"AuthorID": authorID,
-			"Status":   status,
-		})
-	}
+func (r *PRRepository) GetByAuthorID(ctx context.Context, authorID string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE author_id = $1 ORDER BY id`
+	return r.queryPRs(ctx, query, authorID)
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
+// GetByStatus — получить все PR с определенным статусом
+func (r *PRRepository) GetByStatus(ctx context.Context, status string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE status = $1 ORDER BY id`
+	return r.queryPRs(ctx, query, status)
+}
 
-	return prs, nil
+// LockOpenReviewedBy — получить и заблокировать открытые PR, где ревьювером
+// назначен кто-то из reviewerIDs
+func (r *PRRepository) LockOpenReviewedBy(ctx context.Context, reviewerIDs []string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests p 
+	          WHERE p.status = $1 AND EXISTS (
+	              SELECT 1 FROM pr_reviewers r 
+	              WHERE r.pr_id = p.pull_request_id AND r.reviewer_id = ANY($2)
+	          ) 
+	          ORDER BY p.pull_request_id FOR UPDATE`
+	return r.queryPRs(ctx, query, domain.PRStatusOpen, pq.Array(reviewerIDs))
 }
 
-// GetByStatus — получить все PR с определенным статусом
-func (r *PRRepository) GetByStatus(ctx context.Context, status string) ([]map[string]interface{}, error) {
-	query := `SELECT id, name, author_id, status, created_at, updated_at 
-	          FROM pull_requests WHERE status = $1 ORDER BY id`
+// ListReviewedBy — PR, где пользователь ревьювер, одним запросом по возрастанию ID
+func (r *PRRepository) ListReviewedBy(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests p 
+	          WHERE EXISTS (
+	              SELECT 1 FROM pr_reviewers r 
+	              WHERE r.pr_id = p.pull_request_id AND r.reviewer_id = $1
+	          ) 
+	          ORDER BY p.pull_request_id`
+	return r.queryPRs(ctx, query, reviewerID)
+}
 
-	rows, err := r.db.QueryContext(ctx, query, status)
+// queryPRs — выполнить запрос и прочитать список PR
+func (r *PRRepository) queryPRs(ctx context.Context, query string, args ...interface{}) ([]domain.PullRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var prs []map[string]interface{}
+	var prs []domain.PullRequest
 	for rows.Next() {
-		var id int
-		var name, authorID, status string
-		var createdAt, updatedAt interface{}
-
-		if err := rows.Scan(&id, &name, &authorID, &status, &createdAt, &updatedAt); err != nil {
+		pr, err := scanPR(rows)
+		if err != nil {
 			return nil, err
 		}
-
-		prs = append(prs, map[string]interface{}{
-			"ID":       strconv.Itoa(id),
-			"Name":     name,
-			"AuthorID": authorID,
-			"Status":   status,
-		})
+		prs = append(prs, pr)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -1,32 +1,50 @@
 package storage
 
 import (
+	"avito-2025/internal/domain"
 	"context"
 	"database/sql"
-	"strconv"
+
+	"github.com/lib/pq"
 )
 
+// ReviewerSwap — замена ревьювера OldID на NewID в PR
+type ReviewerSwap struct {
+	PRID  string
+	OldID string
+	NewID string
+}
+
 type PRReviewerRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewPRReviewerRepository(db *sql.DB) *PRReviewerRepository {
+func NewPRReviewerRepository(db DBTX) *PRReviewerRepository {
 	return &PRReviewerRepository{db: db}
 }
 
-// AssignReviewer — назначить ревьювера на PR
+// WithTx — копия репозитория, работающая внутри транзакции tx
+func (r *PRReviewerRepository) WithTx(tx *sql.Tx) *PRReviewerRepository {
+	return &PRReviewerRepository{db: tx}
+}
+
+// AssignReviewer — назначить ревьювера на PR.
+// Если он уже назначен, возвращает ErrAlreadyExists.
 func (r *PRReviewerRepository) AssignReviewer(ctx context.Context, prID string, reviewerID string) error {
 	query := `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at) 
 	          VALUES ($1, $2, NOW())
 	          ON CONFLICT (pr_id, reviewer_id) DO NOTHING`
 
-	_, err := r.db.ExecContext(ctx, query, prID, reviewerID)
-	return err
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
 }
 
 // GetByPR — получить всех ревьюверов PR по string ID
 func (r *PRReviewerRepository) GetByPR(ctx context.Context, prID string) ([]string, error) {
-	query := `SELECT reviewer_id FROM pr_reviewers WHERE pr_id = $1 ORDER BY assigned_at`
+	query := `SELECT reviewer_id FROM pr_reviewers WHERE pr_id = $1 ORDER BY assigned_at, id`
 
 	rows, err := r.db.QueryContext(ctx, query, prID)
 	if err != nil {
@@ -50,13 +68,139 @@ func (r *PRReviewerRepository) GetByPR(ctx context.Context, prID string) ([]stri
 	return reviewerIDs, nil
 }
 
-// RemoveReviewer — удалить ревьювера с PR
-func (r *PRReviewerRepository) RemoveReviewer(ctx context.Context, prID string, reviewerID string) error {
+// RemoveReviewer — удалить ревьювера с PR. Возвращает false, если он не был назначен.
+func (r *PRReviewerRepository) RemoveReviewer(ctx context.Context, prID string, reviewerID string) (bool, error) {
 	query := `DELETE FROM pr_reviewers WHERE pr_id = $1 AND reviewer_id = $2`
-	_, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReplaceReviewer — заменить ревьювера oldID на newID одним UPDATE.
+// Возвращает false, если oldID не был назначен на PR.
+func (r *PRReviewerRepository) ReplaceReviewer(ctx context.Context, prID string, oldID string, newID string) (bool, error) {
+	query := `UPDATE pr_reviewers SET reviewer_id = $3, assigned_at = NOW() 
+	          WHERE pr_id = $1 AND reviewer_id = $2`
+
+	res, err := r.db.ExecContext(ctx, query, prID, oldID, newID)
+	if err != nil {
+		return false, translate(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// GetByPRs — ревьюверы нескольких PR одним запросом: pr_id -> reviewer_id
+func (r *PRReviewerRepository) GetByPRs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	query := `SELECT pr_id, reviewer_id FROM pr_reviewers 
+	          WHERE pr_id = ANY($1) ORDER BY pr_id, assigned_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(prIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string][]string, len(prIDs))
+	for rows.Next() {
+		var prID, reviewerID string
+		if err := rows.Scan(&prID, &reviewerID); err != nil {
+			return nil, err
+		}
+		result[prID] = append(result[prID], reviewerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReplaceReviewers — выполнить пачку замен одним UPDATE
+func (r *PRReviewerRepository) ReplaceReviewers(ctx context.Context, swaps []ReviewerSwap) error {
+	if len(swaps) == 0 {
+		return nil
+	}
+
+	prIDs := make([]string, len(swaps))
+	oldIDs := make([]string, len(swaps))
+	newIDs := make([]string, len(swaps))
+	for i, sw := range swaps {
+		prIDs[i], oldIDs[i], newIDs[i] = sw.PRID, sw.OldID, sw.NewID
+	}
+
+	query := `UPDATE pr_reviewers r SET reviewer_id = v.new_id, assigned_at = NOW() 
+	          FROM unnest($1::varchar[], $2::varchar[], $3::varchar[]) AS v(pr_id, old_id, new_id) 
+	          WHERE r.pr_id = v.pr_id AND r.reviewer_id = v.old_id`
+
+	_, err := r.db.ExecContext(ctx, query, pq.Array(prIDs), pq.Array(oldIDs), pq.Array(newIDs))
+	return translate(err)
+}
+
+// RemoveReviewers — снять пачку ревьюверов одним DELETE (NewID не используется)
+func (r *PRReviewerRepository) RemoveReviewers(ctx context.Context, slots []ReviewerSwap) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	prIDs := make([]string, len(slots))
+	reviewerIDs := make([]string, len(slots))
+	for i, sl := range slots {
+		prIDs[i], reviewerIDs[i] = sl.PRID, sl.OldID
+	}
+
+	query := `DELETE FROM pr_reviewers r 
+	          USING unnest($1::varchar[], $2::varchar[]) AS v(pr_id, reviewer_id) 
+	          WHERE r.pr_id = v.pr_id AND r.reviewer_id = v.reviewer_id`
+
+	_, err := r.db.ExecContext(ctx, query, pq.Array(prIDs), pq.Array(reviewerIDs))
 	return err
 }
 
+// OpenReviewCounts — число OPEN PR на ревью у каждого из userIDs одним запросом.
+// Пользователи без открытых ревью в результат не попадают (нагрузка 0).
+func (r *PRReviewerRepository) OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	query := `SELECT r.reviewer_id, COUNT(*) FROM pr_reviewers r 
+	          JOIN pull_requests p ON p.pull_request_id = r.pr_id 
+	          WHERE p.status = $1 AND r.reviewer_id = ANY($2) 
+	          GROUP BY r.reviewer_id`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.PRStatusOpen, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var id string
+		var n int
+		if err := rows.Scan(&id, &n); err != nil {
+			return nil, err
+		}
+		counts[id] = n
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 // GetReviewersCount — получить количество ревьюверов для PR
 func (r *PRReviewerRepository) GetReviewersCount(ctx context.Context, prID string) (int, error) {
 	query := `SELECT COUNT(*) FROM pr_reviewers WHERE pr_id = $1`
@@ -92,7 +236,7 @@ func (r *PRReviewerRepository) GetPRsByReviewer(ctx context.Context, reviewerID
 }
 
 // List — получить все связи ревьювер-PR
-func (r *PRReviewerRepository) List(ctx context.Context) ([]map[string]interface{}, error) {
+func (r *PRReviewerRepository) List(ctx context.Context) ([]domain.PRReviewer, error) {
 	query := `SELECT id, pr_id, reviewer_id, assigned_at FROM pr_reviewers ORDER BY id`
 
 	rows, err := r.db.QueryContext(ctx, query)
@@ -101,21 +245,17 @@ func (r *PRReviewerRepository) List(ctx context.Context) ([]map[string]interface
 	}
 	defer rows.Close()
 
-	var reviewers []map[string]interface{}
+	var reviewers []domain.PRReviewer
 	for rows.Next() {
-		var id int
-		var prID, reviewerID string
-		var assignedAt interface{}
+		var rv domain.PRReviewer
+		var assignedAt sql.NullTime
 
-		if err := rows.Scan(&id, &prID, &reviewerID, &assignedAt); err != nil {
+		if err := rows.Scan(&rv.ID, &rv.PRID, &rv.ReviewerID, &assignedAt); err != nil {
 			return nil, err
 		}
 
-		reviewers = append(reviewers, map[string]interface{}{
-			"ID":         strconv.Itoa(id),
-			"PRID":       prID,
-			"ReviewerID": reviewerID,
-		})
+		rv.AssignedAt = timeOrZero(assignedAt)
+		reviewers = append(reviewers, rv)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// expectedSchema — колонки и их типы, на которые рассчитаны запросы репозиториев
+var expectedSchema = map[string]map[string]string{
+	"teams": {
+		"id":         "integer",
+		"name":       "character varying",
+		"created_at": "timestamp without time zone",
+	},
+	"users": {
+		"id":         "integer",
+		"user_id":    "character varying",
+		"username":   "character varying",
+		"team_name":  "character varying",
+		"is_active":  "boolean",
+		"created_at": "timestamp without time zone",
+		"updated_at": "timestamp without time zone",
+	},
+	"pull_requests": {
+		"id":              "integer",
+		"pull_request_id": "character varying",
+		"name":            "character varying",
+		"author_id":       "character varying",
+		"status":          "character varying",
+		"created_at":      "timestamp without time zone",
+		"updated_at":      "timestamp without time zone",
+		"merged_at":       "timestamp without time zone",
+	},
+	"pr_reviewers": {
+		"id":          "integer",
+		"pr_id":       "character varying",
+		"reviewer_id": "character varying",
+		"assigned_at": "timestamp without time zone",
+	},
+	"review_assignment_events": {
+		"id":              "bigint",
+		"pr_id":           "character varying",
+		"event_type":      "character varying",
+		"old_reviewer_id": "character varying",
+		"new_reviewer_id": "character varying",
+		"actor":           "character varying",
+		"reason":          "character varying",
+		"created_at":      "timestamp without time zone",
+	},
+}
+
+// VerifySchema сверяет схему БД с ожиданиями репозиториев через information_schema.
+// Возвращает ошибку со списком всех расхождений.
+func VerifySchema(ctx context.Context, db *sql.DB) error {
+	query := `SELECT table_name, column_name, data_type 
+	          FROM information_schema.columns 
+	          WHERE table_schema = current_schema()`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	actual := make(map[string]map[string]string)
+	for rows.Next() {
+		var table, column, dataType string
+		if err := rows.Scan(&table, &column, &dataType); err != nil {
+			return err
+		}
+		if actual[table] == nil {
+			actual[table] = make(map[string]string)
+		}
+		actual[table][column] = dataType
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var problems []string
+	for table, columns := range expectedSchema {
+		for column, wantType := range columns {
+			gotType, ok := actual[table][column]
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("%s.%s is missing", table, column))
+			case gotType != wantType:
+				problems = append(problems, fmt.Sprintf("%s.%s has type %s, want %s", table, column, gotType, wantType))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("schema mismatch: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+)
+
+// EventStore — журнал событий назначения ревьюверов (только запись и чтение)
+type EventStore struct {
+	db storage.DBTX
+}
+
+// Append — дописать события одним INSERT в порядке передачи.
+// Пустые OldReviewerID/NewReviewerID сохраняются как NULL.
+func (r *EventStore) Append(ctx context.Context, events ...domain.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	batch, err := jsonArray(events)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO review_assignment_events
+	              (pr_id, event_type, old_reviewer_id, new_reviewer_id, actor, reason, created_at)
+	          SELECT json_extract(value, '$.PRID'), json_extract(value, '$.Type'),
+	                 NULLIF(json_extract(value, '$.OldReviewerID'), ''),
+	                 NULLIF(json_extract(value, '$.NewReviewerID'), ''),
+	                 json_extract(value, '$.Actor'), json_extract(value, '$.Reason'), ?2
+	          FROM json_each(?1)
+	          ORDER BY key`
+
+	_, err = r.db.ExecContext(ctx, query, batch, now())
+	return translate(err)
+}
+
+// ListByPR — история PR в хронологическом порядке
+func (r *EventStore) ListByPR(ctx context.Context, prID string) ([]domain.AssignmentEvent, error) {
+	query := `SELECT id, pr_id, event_type, COALESCE(old_reviewer_id, ''), COALESCE(new_reviewer_id, ''),
+	                 actor, reason, created_at
+	          FROM review_assignment_events WHERE pr_id = ?1 ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.AssignmentEvent, 0)
+	for rows.Next() {
+		var e domain.AssignmentEvent
+		if err := rows.Scan(&e.ID, &e.PRID, &e.Type, &e.OldReviewerID, &e.NewReviewerID,
+			&e.Actor, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
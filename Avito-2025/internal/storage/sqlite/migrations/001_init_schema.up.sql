@@ -0,0 +1,34 @@
+-- Создание таблицы команд
+CREATE TABLE teams (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) UNIQUE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы пользователей
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(255) UNIQUE NOT NULL,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы pull requests
+CREATE TABLE pull_requests (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title VARCHAR(512) NOT NULL,
+    author_id INT NOT NULL REFERENCES users(id),
+    status VARCHAR(50) DEFAULT 'OPEN',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Создание таблицы ревьюверов PR
+CREATE TABLE pr_reviewers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id INT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id INT NOT NULL REFERENCES users(id),
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(pr_id, reviewer_id)
+);
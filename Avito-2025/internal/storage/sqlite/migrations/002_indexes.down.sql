@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_team_id;
+DROP INDEX IF EXISTS idx_users_is_active;
+DROP INDEX IF EXISTS idx_pr_author_id;
+DROP INDEX IF EXISTS idx_pr_status;
+DROP INDEX IF EXISTS idx_pr_reviewers_pr_id;
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_id;
@@ -0,0 +1,48 @@
+-- pr_reviewers: обратно на внутренний ID PR
+CREATE TABLE pr_reviewers_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id INT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id INT NOT NULL REFERENCES users(id),
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(pr_id, reviewer_id)
+);
+INSERT INTO pr_reviewers_old (id, pr_id, reviewer_id, assigned_at)
+SELECT r.id, p.id, r.reviewer_id, r.assigned_at
+FROM pr_reviewers r JOIN pull_requests p ON p.pull_request_id = r.pr_id;
+DROP TABLE pr_reviewers;
+ALTER TABLE pr_reviewers_old RENAME TO pr_reviewers;
+CREATE INDEX idx_pr_reviewers_pr_id ON pr_reviewers(pr_id);
+CREATE INDEX idx_pr_reviewers_reviewer_id ON pr_reviewers(reviewer_id);
+
+-- pull_requests
+CREATE TABLE pull_requests_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title VARCHAR(512) NOT NULL,
+    author_id INT NOT NULL REFERENCES users(id),
+    status VARCHAR(50) DEFAULT 'OPEN',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO pull_requests_old (id, title, author_id, status, created_at, updated_at)
+SELECT id, name, author_id, status, created_at, updated_at
+FROM pull_requests;
+DROP TABLE pull_requests;
+ALTER TABLE pull_requests_old RENAME TO pull_requests;
+CREATE INDEX idx_pr_author_id ON pull_requests(author_id);
+CREATE INDEX idx_pr_status ON pull_requests(status);
+
+-- users
+CREATE TABLE users_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(255) UNIQUE NOT NULL,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO users_old (id, username, team_id, is_active, created_at)
+SELECT u.id, u.username, t.id, u.is_active, u.created_at
+FROM users u JOIN teams t ON t.name = u.team_name;
+DROP TABLE users;
+ALTER TABLE users_old RENAME TO users;
+CREATE INDEX idx_users_team_id ON users(team_id);
+CREATE INDEX idx_users_is_active ON users(is_active);
@@ -0,0 +1,51 @@
+-- pr_reviewers.reviewer_id обратно на внутренний ID
+CREATE TABLE pr_reviewers_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    reviewer_id INT NOT NULL REFERENCES users(id),
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(pr_id, reviewer_id)
+);
+INSERT INTO pr_reviewers_old (id, pr_id, reviewer_id, assigned_at)
+SELECT r.id, r.pr_id, u.id, r.assigned_at
+FROM pr_reviewers r JOIN users u ON u.user_id = r.reviewer_id;
+DROP TABLE pr_reviewers;
+ALTER TABLE pr_reviewers_old RENAME TO pr_reviewers;
+CREATE INDEX idx_pr_reviewers_pr_id ON pr_reviewers(pr_id);
+CREATE INDEX idx_pr_reviewers_reviewer_id ON pr_reviewers(reviewer_id);
+
+-- pull_requests.author_id обратно на внутренний ID
+CREATE TABLE pull_requests_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(512) NOT NULL,
+    author_id INT NOT NULL REFERENCES users(id),
+    status VARCHAR(50) DEFAULT 'OPEN',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    merged_at TIMESTAMP,
+    pull_request_id VARCHAR(255) NOT NULL UNIQUE
+);
+INSERT INTO pull_requests_old (id, name, author_id, status, created_at, updated_at, merged_at, pull_request_id)
+SELECT p.id, p.name, u.id, p.status, p.created_at, p.updated_at, p.merged_at, p.pull_request_id
+FROM pull_requests p JOIN users u ON u.user_id = p.author_id;
+DROP TABLE pull_requests;
+ALTER TABLE pull_requests_old RENAME TO pull_requests;
+CREATE INDEX idx_pr_author_id ON pull_requests(author_id);
+CREATE INDEX idx_pr_status ON pull_requests(status);
+
+-- users
+CREATE TABLE users_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(255) UNIQUE NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO users_old (id, username, team_name, is_active, created_at, updated_at)
+SELECT id, username, team_name, is_active, created_at, updated_at
+FROM users;
+DROP TABLE users;
+ALTER TABLE users_old RENAME TO users;
+CREATE INDEX idx_users_is_active ON users(is_active);
+CREATE INDEX idx_users_team_name ON users(team_name);
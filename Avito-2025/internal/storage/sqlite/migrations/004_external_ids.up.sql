@@ -0,0 +1,53 @@
+-- users: внешний строковый ID, который выбирает клиент (u1, u2, ...).
+-- Пользователь идентифицируется по user_id, имена могут повторяться
+CREATE TABLE users_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id VARCHAR(255) NOT NULL UNIQUE,
+    username VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO users_new (id, user_id, username, team_name, is_active, created_at, updated_at)
+SELECT id, CAST(id AS TEXT), username, team_name, is_active, created_at, updated_at
+FROM users;
+DROP TABLE users;
+ALTER TABLE users_new RENAME TO users;
+CREATE INDEX idx_users_is_active ON users(is_active);
+CREATE INDEX idx_users_team_name ON users(team_name);
+
+-- pull_requests.author_id ссылается на внешний ID пользователя
+CREATE TABLE pull_requests_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(512) NOT NULL,
+    author_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON UPDATE CASCADE,
+    status VARCHAR(50) DEFAULT 'OPEN',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    merged_at TIMESTAMP,
+    pull_request_id VARCHAR(255) NOT NULL UNIQUE
+);
+INSERT INTO pull_requests_new (id, name, author_id, status, created_at, updated_at, merged_at, pull_request_id)
+SELECT id, name, CAST(author_id AS TEXT), status, created_at, updated_at, merged_at, pull_request_id
+FROM pull_requests;
+DROP TABLE pull_requests;
+ALTER TABLE pull_requests_new RENAME TO pull_requests;
+CREATE INDEX idx_pr_author_id ON pull_requests(author_id);
+CREATE INDEX idx_pr_status ON pull_requests(status);
+
+-- pr_reviewers.reviewer_id ссылается на внешний ID пользователя
+CREATE TABLE pr_reviewers_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON UPDATE CASCADE,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(pr_id, reviewer_id)
+);
+INSERT INTO pr_reviewers_new (id, pr_id, reviewer_id, assigned_at)
+SELECT id, pr_id, CAST(reviewer_id AS TEXT), assigned_at
+FROM pr_reviewers;
+DROP TABLE pr_reviewers;
+ALTER TABLE pr_reviewers_new RENAME TO pr_reviewers;
+CREATE INDEX idx_pr_reviewers_pr_id ON pr_reviewers(pr_id);
+CREATE INDEX idx_pr_reviewers_reviewer_id ON pr_reviewers(reviewer_id);
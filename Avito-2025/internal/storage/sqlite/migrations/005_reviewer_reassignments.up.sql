@@ -0,0 +1,13 @@
+-- Журнал переназначений: pr_reviewers хранит только текущий состав,
+-- а статистике нужно знать, с кого ревью снимали
+CREATE TABLE reviewer_reassignments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    old_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON UPDATE CASCADE,
+    new_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON UPDATE CASCADE,
+    reassigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reviewer_reassignments_pr_id ON reviewer_reassignments(pr_id);
+CREATE INDEX idx_reviewer_reassignments_old_reviewer_id ON reviewer_reassignments(old_reviewer_id);
+CREATE INDEX idx_pr_reviewers_assigned_at ON pr_reviewers(assigned_at);
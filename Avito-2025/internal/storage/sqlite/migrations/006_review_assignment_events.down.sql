@@ -0,0 +1,23 @@
+CREATE TABLE reviewer_reassignments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    old_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON UPDATE CASCADE,
+    new_reviewer_id VARCHAR(255) REFERENCES users(user_id) ON UPDATE CASCADE,
+    reassigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reviewer_reassignments_pr_id ON reviewer_reassignments(pr_id);
+CREATE INDEX idx_reviewer_reassignments_old_reviewer_id ON reviewer_reassignments(old_reviewer_id);
+
+-- Возвращаем только замены и снятия для существующих PR и пользователей
+INSERT INTO reviewer_reassignments (pr_id, old_reviewer_id, new_reviewer_id, reassigned_at)
+SELECT e.pr_id, e.old_reviewer_id, e.new_reviewer_id, e.created_at
+FROM review_assignment_events e
+WHERE e.event_type IN ('reassigned', 'unassigned')
+  AND EXISTS (SELECT 1 FROM pull_requests p WHERE p.pull_request_id = e.pr_id)
+  AND EXISTS (SELECT 1 FROM users u WHERE u.user_id = e.old_reviewer_id)
+  AND (e.new_reviewer_id IS NULL OR EXISTS (SELECT 1 FROM users u WHERE u.user_id = e.new_reviewer_id))
+ORDER BY e.id;
+
+-- Триггеры удаляются вместе с таблицей
+DROP TABLE review_assignment_events;
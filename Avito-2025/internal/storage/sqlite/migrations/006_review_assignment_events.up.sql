@@ -0,0 +1,55 @@
+-- Журнал переназначений (005) становится журналом всех событий назначения ревьюверов.
+-- Только дописывается: история должна пережить удаление пользователей, поэтому внешних ключей нет.
+-- SQLite не умеет снимать ограничения с таблицы, поэтому она пересобирается с переносом данных
+CREATE TABLE review_assignment_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pr_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('assigned', 'unassigned', 'reassigned')),
+    old_reviewer_id VARCHAR(255),
+    new_reviewer_id VARCHAR(255),
+    actor VARCHAR(255) NOT NULL,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('auto', 'manual', 'deactivation')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (event_type = 'assigned' AND old_reviewer_id IS NULL AND new_reviewer_id IS NOT NULL) OR
+        (event_type = 'unassigned' AND old_reviewer_id IS NOT NULL AND new_reviewer_id IS NULL) OR
+        (event_type = 'reassigned' AND old_reviewer_id IS NOT NULL AND new_reviewer_id IS NOT NULL)
+    )
+);
+
+CREATE INDEX idx_review_assignment_events_pr_id ON review_assignment_events(pr_id, id);
+CREATE INDEX idx_review_assignment_events_old_reviewer_id ON review_assignment_events(old_reviewer_id);
+
+CREATE TRIGGER review_assignment_events_no_update
+    BEFORE UPDATE ON review_assignment_events
+BEGIN
+    SELECT RAISE(ABORT, 'review_assignment_events is append-only');
+END;
+
+CREATE TRIGGER review_assignment_events_no_delete
+    BEFORE DELETE ON review_assignment_events
+BEGIN
+    SELECT RAISE(ABORT, 'review_assignment_events is append-only');
+END;
+
+-- Записи журнала переназначений сохраняют id: замены без нового ревьювера были снятиями при деактивации
+INSERT INTO review_assignment_events (id, pr_id, event_type, old_reviewer_id, new_reviewer_id, actor, reason, created_at)
+SELECT id, pr_id,
+       CASE WHEN new_reviewer_id IS NULL THEN 'unassigned' ELSE 'reassigned' END,
+       old_reviewer_id, new_reviewer_id, 'system',
+       CASE WHEN new_reviewer_id IS NULL THEN 'deactivation' ELSE 'manual' END,
+       reassigned_at
+FROM reviewer_reassignments
+ORDER BY id;
+
+-- Текущие назначения, которые не появились через переназначение, считаем автоматическими
+INSERT INTO review_assignment_events (pr_id, event_type, new_reviewer_id, actor, reason, created_at)
+SELECT r.pr_id, 'assigned', r.reviewer_id, 'system', 'auto', COALESCE(r.assigned_at, CURRENT_TIMESTAMP)
+FROM pr_reviewers r
+WHERE NOT EXISTS (
+    SELECT 1 FROM reviewer_reassignments rr
+    WHERE rr.pr_id = r.pr_id AND rr.new_reviewer_id = r.reviewer_id
+)
+ORDER BY r.assigned_at, r.id;
+
+DROP TABLE reviewer_reassignments;
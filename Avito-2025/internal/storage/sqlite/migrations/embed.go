@@ -0,0 +1,10 @@
+// Package migrations содержит SQL-миграции SQLite, встроенные в бинарник.
+// Версии повторяют миграции Postgres из корневого migrations/.
+package migrations
+
+import "embed"
+
+// FS — файлы вида NNN_name.up.sql / NNN_name.down.sql
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,131 @@
+package sqlite
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"database/sql"
+)
+
+type PRStore struct {
+	db storage.DBTX
+}
+
+const prColumns = `pull_request_id, name, author_id, status, created_at, updated_at, merged_at`
+
+// scanPR — прочитать строку pull_requests в domain.PullRequest
+func scanPR(row rowScanner) (domain.PullRequest, error) {
+	var pr domain.PullRequest
+	var createdAt, updatedAt, mergedAt sql.NullTime
+
+	if err := row.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &createdAt, &updatedAt, &mergedAt); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	pr.CreatedAt = timeOrZero(createdAt)
+	pr.UpdatedAt = timeOrZero(updatedAt)
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+	return pr, nil
+}
+
+// Create — создать PR. Если PR с таким ID уже есть, возвращает storage.ErrAlreadyExists.
+func (r *PRStore) Create(ctx context.Context, prID string, prName string, authorID string, status string) error {
+	query := `INSERT INTO pull_requests (pull_request_id, name, author_id, status, created_at, updated_at)
+	          VALUES (?1, ?2, ?3, ?4, ?5, ?5)
+	          ON CONFLICT (pull_request_id) DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, query, prID, prName, authorID, status, now())
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
+}
+
+// GetByID — PR по ID или nil
+func (r *PRStore) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE pull_request_id = ?1`
+
+	pr, err := scanPR(r.db.QueryRowContext(ctx, query, prID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pr, nil
+}
+
+// GetForUpdate — в SQLite нет FOR UPDATE: транзакция уже держит блокировку записи (BEGIN IMMEDIATE)
+func (r *PRStore) GetForUpdate(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return r.GetByID(ctx, prID)
+}
+
+// MarkMerged — перевести PR из OPEN в MERGED одним условным UPDATE.
+// Возвращает true только для запроса, который выполнил переход; merged_at ставится один раз.
+func (r *PRStore) MarkMerged(ctx context.Context, prID string) (bool, error) {
+	query := `UPDATE pull_requests
+	          SET status = ?2, merged_at = ?4, updated_at = ?4
+	          WHERE pull_request_id = ?1 AND status = ?3`
+
+	res, err := r.db.ExecContext(ctx, query, prID, domain.PRStatusMerged, domain.PRStatusOpen, now())
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// LockOpenReviewedBy — открытые PR, где ревьювером назначен кто-то из reviewerIDs
+func (r *PRStore) LockOpenReviewedBy(ctx context.Context, reviewerIDs []string) ([]domain.PullRequest, error) {
+	ids, err := jsonArray(reviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + prColumns + ` FROM pull_requests p
+	          WHERE p.status = ?1 AND EXISTS (
+	              SELECT 1 FROM pr_reviewers r
+	              WHERE r.pr_id = p.pull_request_id
+	                AND r.reviewer_id IN (SELECT value FROM json_each(?2))
+	          )
+	          ORDER BY p.pull_request_id`
+
+	return r.queryPRs(ctx, query, domain.PRStatusOpen, ids)
+}
+
+// ListReviewedBy — PR, где пользователь ревьювер, по возрастанию ID
+func (r *PRStore) ListReviewedBy(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests p
+	          WHERE EXISTS (
+	              SELECT 1 FROM pr_reviewers r
+	              WHERE r.pr_id = p.pull_request_id AND r.reviewer_id = ?1
+	          )
+	          ORDER BY p.pull_request_id`
+	return r.queryPRs(ctx, query, reviewerID)
+}
+
+// queryPRs — выполнить запрос и прочитать список PR
+func (r *PRStore) queryPRs(ctx context.Context, query string, args ...interface{}) ([]domain.PullRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []domain.PullRequest
+	for rows.Next() {
+		pr, err := scanPR(rows)
+		if err != nil {
+			return nil, err
+		}
+		prs = append(prs, pr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
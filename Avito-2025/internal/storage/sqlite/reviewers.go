@@ -0,0 +1,195 @@
+package sqlite
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+)
+
+type ReviewerStore struct {
+	db storage.DBTX
+}
+
+// AssignReviewer — назначить ревьювера на PR.
+// Если он уже назначен, возвращает storage.ErrAlreadyExists.
+func (r *ReviewerStore) AssignReviewer(ctx context.Context, prID string, reviewerID string) error {
+	query := `INSERT INTO pr_reviewers (pr_id, reviewer_id, assigned_at)
+	          VALUES (?1, ?2, ?3)
+	          ON CONFLICT (pr_id, reviewer_id) DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID, now())
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
+}
+
+// RemoveReviewer — снять ревьювера с PR. Возвращает false, если он не был назначен.
+func (r *ReviewerStore) RemoveReviewer(ctx context.Context, prID string, reviewerID string) (bool, error) {
+	query := `DELETE FROM pr_reviewers WHERE pr_id = ?1 AND reviewer_id = ?2`
+
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// ReplaceReviewer — заменить ревьювера oldID на newID одним UPDATE.
+// Возвращает false, если oldID не был назначен на PR.
+func (r *ReviewerStore) ReplaceReviewer(ctx context.Context, prID string, oldID string, newID string) (bool, error) {
+	query := `UPDATE pr_reviewers SET reviewer_id = ?3, assigned_at = ?4
+	          WHERE pr_id = ?1 AND reviewer_id = ?2`
+
+	res, err := r.db.ExecContext(ctx, query, prID, oldID, newID, now())
+	if err != nil {
+		return false, translate(err)
+	}
+	return affected(res)
+}
+
+// ReplaceReviewers — выполнить пачку замен одним UPDATE ... FROM json_each
+func (r *ReviewerStore) ReplaceReviewers(ctx context.Context, swaps []storage.ReviewerSwap) error {
+	if len(swaps) == 0 {
+		return nil
+	}
+
+	batch, err := jsonArray(swaps)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE pr_reviewers AS r SET reviewer_id = v.new_id, assigned_at = ?2
+	          FROM (SELECT json_extract(value, '$.PRID') AS pr_id,
+	                       json_extract(value, '$.OldID') AS old_id,
+	                       json_extract(value, '$.NewID') AS new_id
+	                FROM json_each(?1)) AS v
+	          WHERE r.pr_id = v.pr_id AND r.reviewer_id = v.old_id`
+
+	_, err = r.db.ExecContext(ctx, query, batch, now())
+	return translate(err)
+}
+
+// RemoveReviewers — снять пачку ревьюверов одним DELETE (NewID не используется)
+func (r *ReviewerStore) RemoveReviewers(ctx context.Context, slots []storage.ReviewerSwap) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	batch, err := jsonArray(slots)
+	if err != nil {
+		return err
+	}
+
+	query := `DELETE FROM pr_reviewers
+	          WHERE (pr_id, reviewer_id) IN (
+	              SELECT json_extract(value, '$.PRID'), json_extract(value, '$.OldID') FROM json_each(?1)
+	          )`
+
+	_, err = r.db.ExecContext(ctx, query, batch)
+	return err
+}
+
+// GetByPR — ревьюверы PR в порядке назначения
+func (r *ReviewerStore) GetByPR(ctx context.Context, prID string) ([]string, error) {
+	query := `SELECT reviewer_id FROM pr_reviewers WHERE pr_id = ?1 ORDER BY assigned_at, id`
+	return r.queryIDs(ctx, query, prID)
+}
+
+// GetByPRs — ревьюверы нескольких PR одним запросом: pr_id -> reviewer_id
+func (r *ReviewerStore) GetByPRs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	ids, err := jsonArray(prIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT pr_id, reviewer_id FROM pr_reviewers
+	          WHERE pr_id IN (SELECT value FROM json_each(?1)) ORDER BY pr_id, assigned_at, id`
+
+	rows, err := r.db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string][]string, len(prIDs))
+	for rows.Next() {
+		var prID, reviewerID string
+		if err := rows.Scan(&prID, &reviewerID); err != nil {
+			return nil, err
+		}
+		result[prID] = append(result[prID], reviewerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetPRsByReviewer — PR, где пользователь ревьювер, по возрастанию ID
+func (r *ReviewerStore) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]string, error) {
+	query := `SELECT DISTINCT pr_id FROM pr_reviewers WHERE reviewer_id = ?1 ORDER BY pr_id`
+	return r.queryIDs(ctx, query, reviewerID)
+}
+
+// OpenReviewCounts — число OPEN PR на ревью у каждого из userIDs одним запросом.
+// Пользователи без открытых ревью в результат не попадают (нагрузка 0).
+func (r *ReviewerStore) OpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	ids, err := jsonArray(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT r.reviewer_id, COUNT(*) FROM pr_reviewers r
+	          JOIN pull_requests p ON p.pull_request_id = r.pr_id
+	          WHERE p.status = ?1 AND r.reviewer_id IN (SELECT value FROM json_each(?2))
+	          GROUP BY r.reviewer_id`
+
+	rows, err := r.db.QueryContext(ctx, query, domain.PRStatusOpen, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var id string
+		var n int
+		if err := rows.Scan(&id, &n); err != nil {
+			return nil, err
+		}
+		counts[id] = n
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// queryIDs — выполнить запрос из одной строковой колонки
+func (r *ReviewerStore) queryIDs(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,174 @@
+// Package sqlite — хранилище на SQLite для однонодовых развёртываний без Postgres.
+// Контракты те же, что у репозиториев Postgres; запросы переписаны под диалект SQLite:
+// массивы передаются JSON и разворачиваются json_each, время записи задаётся из Go.
+package sqlite
+
+import (
+	"avito-2025/internal/migrate"
+	"avito-2025/internal/service"
+	"avito-2025/internal/storage"
+	"avito-2025/internal/storage/sqlite/migrations"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Open — открыть (или создать) файл базы.
+// Транзакции начинаются с BEGIN IMMEDIATE: запись в SQLite и так одна,
+// а ранний захват блокировки заменяет SELECT ... FOR UPDATE и не даёт взаимоблокировок.
+// WAL позволяет читать вне транзакции, пока она открыта.
+func Open(path string) (*sql.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate&_loc=UTC", path)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// Проверяем, что файл открывается
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// NewMigrator — мигратор со встроенными миграциями SQLite
+func NewMigrator(db *sql.DB) (*migrate.Migrator, error) {
+	return migrate.NewWithDialect(db, migrations.FS, migrate.SQLite)
+}
+
+// Store — хранилища поверх одной базы SQLite
+type Store struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// Stores — хранилища вне транзакции
+func (s *Store) Stores() service.Stores {
+	return storesOver(s.db)
+}
+
+// Stats — агрегаты для статистики назначений
+func (s *Store) Stats() service.StatsStore {
+	return &StatsStore{db: s.db}
+}
+
+// InTx — выполнить fn в транзакции: commit при успехе, rollback при ошибке.
+// Писатель в SQLite один, поэтому конфликтов сериализации и повторов нет.
+func (s *Store) InTx(ctx context.Context, fn func(tx service.Stores) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(storesOver(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func storesOver(db storage.DBTX) service.Stores {
+	return service.Stores{
+		Users:     &UserStore{db: db},
+		Teams:     &TeamStore{db: db},
+		PRs:       &PRStore{db: db},
+		Reviewers: &ReviewerStore{db: db},
+		Events:    &EventStore{db: db},
+	}
+}
+
+// now — время записи вместо NOW(); в UTC, чтобы строки времени сравнивались по порядку
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// utcOrNil — граница периода в том же виде, что и записанное время
+func utcOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// jsonArray — значение для json_each вместо массива Postgres; nil остаётся NULL
+func jsonArray(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if string(b) == "null" {
+		return nil, nil
+	}
+	return string(b), nil
+}
+
+// expectInserted — для INSERT ... ON CONFLICT DO NOTHING: ноль строк значит дубликат
+func expectInserted(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return storage.ErrAlreadyExists
+	}
+	return nil
+}
+
+// affected — была ли затронута хоть одна строка
+func affected(res sql.Result) (bool, error) {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// translate — нарушение UNIQUE превращается в storage.ErrAlreadyExists,
+// нарушение внешнего ключа — в storage.ErrNotFound
+func translate(err error) error {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		return fmt.Errorf("%w: %w", storage.ErrAlreadyExists, err)
+	case sqlite3.ErrConstraintForeignKey:
+		return fmt.Errorf("%w: %w", storage.ErrNotFound, err)
+	}
+	return err
+}
+
+// translateDelete — при удалении нарушение внешнего ключа значит, что на запись ссылаются
+func translateDelete(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+		return fmt.Errorf("%w: %w", storage.ErrReferenced, err)
+	}
+	return err
+}
+
+// rowScanner — общий интерфейс *sql.Row и *sql.Rows для scan-функций
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// timeOrZero — NULL-колонка времени превращается в нулевое time.Time
+func timeOrZero(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+var _ service.TxRunner = (*Store)(nil)
@@ -0,0 +1,62 @@
+package sqlite_test
+
+import (
+	"avito-2025/internal/storage/sqlite"
+	"avito-2025/internal/storage/storetest"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+// openMigrated — новая база в каталоге теста со схемой последней версии
+func openMigrated(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "reviewer.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestSQLiteStoreContract(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) storetest.Backend {
+		store := sqlite.New(openMigrated(t))
+		return storetest.Backend{Stores: store.Stores(), Tx: store, Stats: store.Stats()}
+	})
+}
+
+// Миграции откатываются до нуля и накатываются снова
+func TestSQLiteMigrationsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrated(t)
+
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := migrator.Goto(ctx, 0); err != nil {
+		t.Fatalf("migrate down: %v", err)
+	}
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("migrate up again: %v", err)
+	}
+
+	version, err := migrator.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != migrator.Latest() {
+		t.Fatalf("version: got %d, want %d", version, migrator.Latest())
+	}
+}
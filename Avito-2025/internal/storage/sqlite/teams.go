@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"database/sql"
+)
+
+type TeamStore struct {
+	db storage.DBTX
+}
+
+// scanTeam — прочитать строку teams в domain.Team
+func scanTeam(row rowScanner) (domain.Team, error) {
+	var t domain.Team
+	var createdAt sql.NullTime
+
+	if err := row.Scan(&t.ID, &t.Name, &createdAt); err != nil {
+		return domain.Team{}, err
+	}
+
+	t.CreatedAt = timeOrZero(createdAt)
+	return t, nil
+}
+
+// Create — создать команду. Если команда уже есть, возвращает storage.ErrAlreadyExists.
+func (r *TeamStore) Create(ctx context.Context, teamName string) error {
+	query := `INSERT INTO teams (name, created_at) VALUES (?1, ?2)
+	          ON CONFLICT (name) DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, query, teamName, now())
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
+}
+
+// GetByName — команда по имени или nil
+func (r *TeamStore) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	query := `SELECT id, name, created_at FROM teams WHERE name = ?1`
+
+	t, err := scanTeam(r.db.QueryRowContext(ctx, query, teamName))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// List — все команды в порядке создания
+func (r *TeamStore) List(ctx context.Context) ([]domain.Team, error) {
+	query := `SELECT id, name, created_at FROM teams ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []domain.Team
+	for rows.Next() {
+		t, err := scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// Update — переименовать команду; участники переходят вместе с ней (ON UPDATE CASCADE)
+func (r *TeamStore) Update(ctx context.Context, oldTeamName string, newTeamName string) error {
+	query := `UPDATE teams SET name = ?1 WHERE name = ?2`
+	_, err := r.db.ExecContext(ctx, query, newTeamName, oldTeamName)
+	return translate(err)
+}
+
+// Delete — удалить команду вместе с участниками (ON DELETE CASCADE). Если участник —
+// автор или ревьювер PR, внешние ключи не дают его удалить: storage.ErrReferenced
+func (r *TeamStore) Delete(ctx context.Context, teamName string) error {
+	query := `DELETE FROM teams WHERE name = ?1`
+	_, err := r.db.ExecContext(ctx, query, teamName)
+	return translateDelete(err)
+}
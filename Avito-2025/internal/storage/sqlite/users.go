@@ -0,0 +1,137 @@
+package sqlite
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/storage"
+	"context"
+	"database/sql"
+	"sort"
+)
+
+type UserStore struct {
+	db storage.DBTX
+}
+
+const userColumns = `user_id, username, team_name, is_active, created_at, updated_at`
+
+// scanUser — прочитать строку users в domain.User
+func scanUser(row rowScanner) (domain.User, error) {
+	var u domain.User
+	var createdAt, updatedAt sql.NullTime
+
+	if err := row.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &createdAt, &updatedAt); err != nil {
+		return domain.User{}, err
+	}
+
+	u.CreatedAt = timeOrZero(createdAt)
+	u.UpdatedAt = timeOrZero(updatedAt)
+	return u, nil
+}
+
+// GetByID — пользователь по ID или nil
+func (r *UserStore) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE user_id = ?1`
+
+	u, err := scanUser(r.db.QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// Upsert — создать пользователя или обновить имя, команду и активность существующего
+func (r *UserStore) Upsert(ctx context.Context, userID string, username string, teamName string, isActive bool) error {
+	query := `INSERT INTO users (user_id, username, team_name, is_active, created_at, updated_at)
+	          VALUES (?1, ?2, ?3, ?4, ?5, ?5)
+	          ON CONFLICT (user_id) DO UPDATE
+	          SET username = excluded.username, team_name = excluded.team_name,
+	              is_active = excluded.is_active, updated_at = excluded.updated_at`
+
+	_, err := r.db.ExecContext(ctx, query, userID, username, teamName, isActive, now())
+	return translate(err)
+}
+
+// Update — обновить имя и активность пользователя
+func (r *UserStore) Update(ctx context.Context, userID string, username string, isActive bool) error {
+	query := `UPDATE users SET username = ?1, is_active = ?2, updated_at = ?3 WHERE user_id = ?4`
+	_, err := r.db.ExecContext(ctx, query, username, isActive, now(), userID)
+	return translate(err)
+}
+
+// GetByTeam — все члены команды, включая неактивных
+func (r *UserStore) GetByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
+	query := `SELECT ` + userColumns + `
+	          FROM users WHERE team_name = ?1 ORDER BY user_id`
+	return r.queryUsers(ctx, query, teamName)
+}
+
+// GetActiveMembers — активные члены команды
+func (r *UserStore) GetActiveMembers(ctx context.Context, teamName string) ([]domain.User, error) {
+	query := `SELECT ` + userColumns + `
+	          FROM users WHERE team_name = ?1 AND is_active = TRUE`
+	return r.queryUsers(ctx, query, teamName)
+}
+
+// DeactivateMembers — пометить неактивными участников команды.
+// Если userIDs == nil, деактивируется вся команда. Возвращает ID затронутых.
+func (r *UserStore) DeactivateMembers(ctx context.Context, teamName string, userIDs []string) ([]string, error) {
+	ids, err := jsonArray(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE users SET is_active = FALSE, updated_at = ?3
+	          WHERE team_name = ?1 AND (?2 IS NULL OR user_id IN (SELECT value FROM json_each(?2)))
+	          RETURNING user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, teamName, ids, now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING не гарантирует порядок
+	sort.Strings(result)
+	return result, nil
+}
+
+// queryUsers — выполнить запрос и прочитать список пользователей
+func (r *UserStore) queryUsers(ctx context.Context, query string, args ...interface{}) ([]domain.User, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"avito-2025/internal/domain"
+	"context"
+	"database/sql"
+	"time"
+)
+
+type StatsRepository struct {
+	db DBTX
+}
+
+func NewStatsRepository(db DBTX) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// UserStats — нагрузка по каждому пользователю одним запросом.
+// Назначения и снятия считаются по журналу событий и фильтруются периодом по времени события;
+// merged_reviewed — по assigned_at. open_reviews — текущая нагрузка, период на неё не влияет.
+func (r *StatsRepository) UserStats(ctx context.Context, f domain.StatsFilter) ([]domain.UserAssignmentStats, error) {
+	query := `WITH current AS (
+	              SELECT r.reviewer_id,
+	                     COUNT(*) FILTER (WHERE p.status = 'OPEN') AS open_reviews,
+	                     COUNT(*) FILTER (WHERE p.status = 'MERGED'
+	                                        AND ($2::timestamp IS NULL OR r.assigned_at >= $2) 
+	                                        AND ($3::timestamp IS NULL OR r.assigned_at < $3)) AS merged_reviewed
+	              FROM pr_reviewers r 
+	              JOIN pull_requests p ON p.pull_request_id = r.pr_id
+	              GROUP BY r.reviewer_id
+	          ), assigned AS (
+	              SELECT new_reviewer_id AS reviewer_id, COUNT(*) AS assignments
+	              FROM review_assignment_events
+	              WHERE event_type IN ('assigned', 'reassigned')
+	                AND ($2::timestamp IS NULL OR created_at >= $2) 
+	                AND ($3::timestamp IS NULL OR created_at < $3)
+	              GROUP BY new_reviewer_id
+	          ), away AS (
+	              SELECT old_reviewer_id AS reviewer_id, COUNT(*) AS reassigned_away
+	              FROM review_assignment_events
+	              WHERE event_type IN ('reassigned', 'unassigned')
+	                AND ($2::timestamp IS NULL OR created_at >= $2) 
+	                AND ($3::timestamp IS NULL OR created_at < $3)
+	              GROUP BY old_reviewer_id
+	          )
+	          SELECT u.user_id, u.username, u.team_name,
+	                 COALESCE(c.open_reviews, 0),
+	                 COALESCE(s.assignments, 0),
+	                 COALESCE(c.merged_reviewed, 0),
+	                 COALESCE(a.reassigned_away, 0)
+	          FROM users u 
+	          LEFT JOIN current c ON c.reviewer_id = u.user_id
+	          LEFT JOIN assigned s ON s.reviewer_id = u.user_id
+	          LEFT JOIN away a ON a.reviewer_id = u.user_id
+	          WHERE ($1 = '' OR u.team_name = $1)
+	          ORDER BY u.user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, f.TeamName, utcOrNil(f.From), utcOrNil(f.To))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]domain.UserAssignmentStats, 0)
+	for rows.Next() {
+		var s domain.UserAssignmentStats
+		if err := rows.Scan(&s.UserID, &s.Username, &s.TeamName,
+			&s.OpenReviews, &s.TotalAssignments, &s.MergedReviewed, &s.ReassignedAway); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// PRStats — число переназначений и время до мержа по каждому PR.
+// Команда — команда автора, период фильтрует PR по created_at.
+func (r *StatsRepository) PRStats(ctx context.Context, f domain.StatsFilter) ([]domain.PRAssignmentStats, error) {
+	query := `SELECT p.pull_request_id, p.name, a.team_name, p.status,
+	                 (SELECT COUNT(*) FROM review_assignment_events e 
+	                  WHERE e.pr_id = p.pull_request_id AND e.event_type IN ('reassigned', 'unassigned')),
+	                 EXTRACT(EPOCH FROM (p.merged_at - p.created_at))
+	          FROM pull_requests p 
+	          JOIN users a ON a.user_id = p.author_id
+	          WHERE ($1 = '' OR a.team_name = $1)
+	            AND ($2::timestamp IS NULL OR p.created_at >= $2) 
+	            AND ($3::timestamp IS NULL OR p.created_at < $3)
+	          ORDER BY p.pull_request_id`
+
+	rows, err := r.db.QueryContext(ctx, query, f.TeamName, utcOrNil(f.From), utcOrNil(f.To))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]domain.PRAssignmentStats, 0)
+	for rows.Next() {
+		var s domain.PRAssignmentStats
+		var seconds sql.NullFloat64
+
+		if err := rows.Scan(&s.PRID, &s.Name, &s.TeamName, &s.Status, &s.Reassignments, &seconds); err != nil {
+			return nil, err
+		}
+
+		if seconds.Valid {
+			d := time.Duration(seconds.Float64 * float64(time.Second))
+			s.TimeToMerge = &d
+		}
+		stats = append(stats, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// utcOrNil — колонки хранят время без зоны (NOW() в UTC),
+// поэтому границы периода приводим к UTC
+func utcOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
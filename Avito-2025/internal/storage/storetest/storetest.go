@@ -0,0 +1,651 @@
+// Package storetest — общий контракт хранилищ из internal/service.
+// Каждая реализация (Postgres, память, SQLite) прогоняет его в своих тестах через Run.
+package storetest
+
+import (
+	"avito-2025/internal/domain"
+	"avito-2025/internal/service"
+	"avito-2025/internal/storage"
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// Backend — проверяемая реализация: хранилища вне транзакции, запуск транзакций и статистика
+type Backend struct {
+	service.Stores
+	Tx    service.TxRunner
+	Stats service.StatsStore
+}
+
+// Run прогоняет контракт. newBackend вызывается на каждый подтест и должен
+// возвращать пустое хранилище.
+func Run(t *testing.T, newBackend func(t *testing.T) Backend) {
+	cases := []struct {
+		name string
+		fn   func(t *testing.T, b Backend)
+	}{
+		{"TeamCreateAndGet", testTeamCreateAndGet},
+		{"UserUpsertAndLookups", testUserUpsertAndLookups},
+		{"DeactivateMembers", testDeactivateMembers},
+		{"PRCreateAndGet", testPRCreateAndGet},
+		{"MarkMergedIsConditional", testMarkMergedIsConditional},
+		{"AssignReviewerConflict", testAssignReviewerConflict},
+		{"MissingReferences", testMissingReferences},
+		{"TeamDeleteKeepsReferencedUsers", testTeamDeleteKeepsReferencedUsers},
+		{"RemoveAndReplaceReviewer", testRemoveAndReplaceReviewer},
+		{"BatchReviewerChanges", testBatchReviewerChanges},
+		{"OpenReviewCountsAndLocks", testOpenReviewCountsAndLocks},
+		{"ListReviewedBy", testListReviewedBy},
+		{"EventsKeepOrder", testEventsKeepOrder},
+		{"TxRollback", testTxRollback},
+		{"ReadOutsideTx", testReadOutsideTx},
+		{"UserStats", testUserStats},
+		{"PRStats", testPRStats},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.fn(t, newBackend(t))
+		})
+	}
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func equal(t *testing.T, what string, got, want interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("%s: got %v, want %v", what, got, want)
+	}
+}
+
+func sorted(ids []string) []string {
+	out := append([]string{}, ids...)
+	sort.Strings(out)
+	return out
+}
+
+func userIDs(users []domain.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+// seed — команда backend с u1..u4 (u4 неактивен) и команда payments с p1
+func seed(t *testing.T, b Backend) {
+	t.Helper()
+	ctx := context.Background()
+
+	must(t, b.Teams.Create(ctx, "backend"))
+	must(t, b.Teams.Create(ctx, "payments"))
+	must(t, b.Users.Upsert(ctx, "u1", "Alice", "backend", true))
+	must(t, b.Users.Upsert(ctx, "u2", "Bob", "backend", true))
+	must(t, b.Users.Upsert(ctx, "u3", "Carol", "backend", true))
+	must(t, b.Users.Upsert(ctx, "u4", "Dave", "backend", false))
+	must(t, b.Users.Upsert(ctx, "p1", "Paul", "payments", true))
+}
+
+func testTeamCreateAndGet(t *testing.T, b Backend) {
+	ctx := context.Background()
+
+	must(t, b.Teams.Create(ctx, "backend"))
+	if err := b.Teams.Create(ctx, "backend"); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("duplicate team: got %v, want ErrAlreadyExists", err)
+	}
+
+	team, err := b.Teams.GetByName(ctx, "backend")
+	must(t, err)
+	if team == nil || team.Name != "backend" {
+		t.Fatalf("GetByName: got %+v", team)
+	}
+
+	missing, err := b.Teams.GetByName(ctx, "nope")
+	must(t, err)
+	if missing != nil {
+		t.Fatalf("missing team: got %+v, want nil", missing)
+	}
+
+	teams, err := b.Teams.List(ctx)
+	must(t, err)
+	equal(t, "List", len(teams), 1)
+}
+
+func testUserUpsertAndLookups(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	// Upsert существующего переносит его в другую команду
+	must(t, b.Users.Upsert(ctx, "u3", "Carol B", "payments", false))
+	u, err := b.Users.GetByID(ctx, "u3")
+	must(t, err)
+	if u == nil || u.Username != "Carol B" || u.TeamName != "payments" || u.IsActive {
+		t.Fatalf("after upsert: got %+v", u)
+	}
+
+	missing, err := b.Users.GetByID(ctx, "nope")
+	must(t, err)
+	if missing != nil {
+		t.Fatalf("missing user: got %+v, want nil", missing)
+	}
+
+	all, err := b.Users.GetByTeam(ctx, "backend")
+	must(t, err)
+	equal(t, "GetByTeam is ordered by user_id", userIDs(all), []string{"u1", "u2", "u4"})
+
+	active, err := b.Users.GetActiveMembers(ctx, "backend")
+	must(t, err)
+	equal(t, "GetActiveMembers", sorted(userIDs(active)), []string{"u1", "u2"})
+
+	must(t, b.Users.Update(ctx, "u4", "Dave", true))
+	active, err = b.Users.GetActiveMembers(ctx, "backend")
+	must(t, err)
+	equal(t, "after Update", sorted(userIDs(active)), []string{"u1", "u2", "u4"})
+}
+
+func testDeactivateMembers(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	// Чужие пользователи не затрагиваются
+	ids, err := b.Users.DeactivateMembers(ctx, "backend", []string{"u2", "p1"})
+	must(t, err)
+	equal(t, "subset", ids, []string{"u2"})
+
+	p1, err := b.Users.GetByID(ctx, "p1")
+	must(t, err)
+	if !p1.IsActive {
+		t.Fatalf("user of another team was deactivated")
+	}
+
+	// nil — вся команда, результат отсортирован
+	ids, err = b.Users.DeactivateMembers(ctx, "backend", nil)
+	must(t, err)
+	equal(t, "whole team", ids, []string{"u1", "u2", "u3", "u4"})
+
+	active, err := b.Users.GetActiveMembers(ctx, "backend")
+	must(t, err)
+	equal(t, "active after deactivation", len(active), 0)
+}
+
+func testPRCreateAndGet(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+	if err := b.PRs.Create(ctx, "pr-1", "Again", "u2", domain.PRStatusOpen); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("duplicate PR: got %v, want ErrAlreadyExists", err)
+	}
+
+	pr, err := b.PRs.GetByID(ctx, "pr-1")
+	must(t, err)
+	if pr == nil || pr.Name != "Add search" || pr.AuthorID != "u1" || pr.Status != domain.PRStatusOpen {
+		t.Fatalf("GetByID: got %+v", pr)
+	}
+	if pr.MergedAt != nil {
+		t.Fatalf("open PR has merged_at %v", pr.MergedAt)
+	}
+
+	missing, err := b.PRs.GetByID(ctx, "nope")
+	must(t, err)
+	if missing != nil {
+		t.Fatalf("missing PR: got %+v, want nil", missing)
+	}
+}
+
+func testMarkMergedIsConditional(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+
+	merged, err := b.PRs.MarkMerged(ctx, "pr-1")
+	must(t, err)
+	equal(t, "first merge", merged, true)
+
+	merged, err = b.PRs.MarkMerged(ctx, "pr-1")
+	must(t, err)
+	equal(t, "second merge", merged, false)
+
+	merged, err = b.PRs.MarkMerged(ctx, "nope")
+	must(t, err)
+	equal(t, "missing PR", merged, false)
+
+	pr, err := b.PRs.GetByID(ctx, "pr-1")
+	must(t, err)
+	if pr.Status != domain.PRStatusMerged || pr.MergedAt == nil {
+		t.Fatalf("after merge: got %+v", pr)
+	}
+}
+
+func testAssignReviewerConflict(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u3"))
+	if err := b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"); !errors.Is(err, storage.ErrAlreadyExists) {
+		t.Fatalf("duplicate assignment: got %v, want ErrAlreadyExists", err)
+	}
+
+	reviewers, err := b.Reviewers.GetByPR(ctx, "pr-1")
+	must(t, err)
+	equal(t, "GetByPR keeps assignment order", reviewers, []string{"u2", "u3"})
+
+	prs, err := b.Reviewers.GetPRsByReviewer(ctx, "u2")
+	must(t, err)
+	equal(t, "GetPRsByReviewer", prs, []string{"pr-1"})
+
+	none, err := b.Reviewers.GetByPR(ctx, "nope")
+	must(t, err)
+	equal(t, "reviewers of missing PR", len(none), 0)
+}
+
+// Ссылка на несуществующую запись — storage.ErrNotFound, как нарушение внешнего ключа
+func testMissingReferences(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	if err := b.Users.Upsert(ctx, "x1", "Xavier", "nope", true); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("user in missing team: got %v, want ErrNotFound", err)
+	}
+	if err := b.PRs.Create(ctx, "pr-1", "Add search", "nope", domain.PRStatusOpen); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("PR by missing author: got %v, want ErrNotFound", err)
+	}
+
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+	if err := b.Reviewers.AssignReviewer(ctx, "pr-1", "nope"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("missing reviewer: got %v, want ErrNotFound", err)
+	}
+	if err := b.Reviewers.AssignReviewer(ctx, "pr-2", "u2"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("missing PR: got %v, want ErrNotFound", err)
+	}
+}
+
+// Удаление команды уносит участников, но не тех, на кого ссылаются PR:
+// так работают внешние ключи pull_requests.author_id и pr_reviewers.reviewer_id
+func testTeamDeleteKeepsReferencedUsers(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "p1"))
+
+	// u1 — автор, p1 — ревьювер: ни одна команда не удаляется
+	for _, team := range []string{"backend", "payments"} {
+		if err := b.Teams.Delete(ctx, team); !errors.Is(err, storage.ErrReferenced) {
+			t.Fatalf("delete %s: got %v, want ErrReferenced", team, err)
+		}
+		kept, err := b.Teams.GetByName(ctx, team)
+		must(t, err)
+		if kept == nil {
+			t.Fatalf("%s deleted despite the error", team)
+		}
+	}
+	members, err := b.Users.GetByTeam(ctx, "backend")
+	must(t, err)
+	equal(t, "backend members", len(members), 4)
+
+	// Без ссылок команда удаляется вместе с участниками, PR остаётся
+	_, err = b.Reviewers.RemoveReviewer(ctx, "pr-1", "p1")
+	must(t, err)
+	must(t, b.Teams.Delete(ctx, "payments"))
+
+	team, err := b.Teams.GetByName(ctx, "payments")
+	must(t, err)
+	user, err := b.Users.GetByID(ctx, "p1")
+	must(t, err)
+	if team != nil || user != nil {
+		t.Fatalf("after delete: team %+v, user %+v", team, user)
+	}
+	pr, err := b.PRs.GetByID(ctx, "pr-1")
+	must(t, err)
+	if pr == nil {
+		t.Fatal("pr-1 deleted with the reviewer's team")
+	}
+
+	// Несуществующая команда — не ошибка
+	must(t, b.Teams.Delete(ctx, "nope"))
+}
+
+func testRemoveAndReplaceReviewer(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+
+	replaced, err := b.Reviewers.ReplaceReviewer(ctx, "pr-1", "u2", "u3")
+	must(t, err)
+	equal(t, "replace assigned", replaced, true)
+
+	replaced, err = b.Reviewers.ReplaceReviewer(ctx, "pr-1", "u2", "u4")
+	must(t, err)
+	equal(t, "replace not assigned", replaced, false)
+
+	reviewers, err := b.Reviewers.GetByPR(ctx, "pr-1")
+	must(t, err)
+	equal(t, "after replace", reviewers, []string{"u3"})
+
+	removed, err := b.Reviewers.RemoveReviewer(ctx, "pr-1", "u3")
+	must(t, err)
+	equal(t, "remove assigned", removed, true)
+
+	removed, err = b.Reviewers.RemoveReviewer(ctx, "pr-1", "u3")
+	must(t, err)
+	equal(t, "remove twice", removed, false)
+}
+
+func testBatchReviewerChanges(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "One", "u1", domain.PRStatusOpen))
+	must(t, b.PRs.Create(ctx, "pr-2", "Two", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-2", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-2", "u3"))
+
+	must(t, b.Reviewers.ReplaceReviewers(ctx, []storage.ReviewerSwap{{PRID: "pr-1", OldID: "u2", NewID: "u3"}}))
+	must(t, b.Reviewers.RemoveReviewers(ctx, []storage.ReviewerSwap{{PRID: "pr-2", OldID: "u2"}}))
+	must(t, b.Reviewers.ReplaceReviewers(ctx, nil))
+	must(t, b.Reviewers.RemoveReviewers(ctx, nil))
+
+	byPR, err := b.Reviewers.GetByPRs(ctx, []string{"pr-1", "pr-2", "nope"})
+	must(t, err)
+	equal(t, "pr-1", byPR["pr-1"], []string{"u3"})
+	equal(t, "pr-2", byPR["pr-2"], []string{"u3"})
+	if _, ok := byPR["nope"]; ok {
+		t.Fatalf("GetByPRs returned reviewers for a missing PR")
+	}
+}
+
+func testOpenReviewCountsAndLocks(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "One", "u1", domain.PRStatusOpen))
+	must(t, b.PRs.Create(ctx, "pr-2", "Two", "u1", domain.PRStatusOpen))
+	must(t, b.PRs.Create(ctx, "pr-3", "Three", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-2", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-3", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-3", "u3"))
+
+	_, err := b.PRs.MarkMerged(ctx, "pr-3")
+	must(t, err)
+
+	// Смерженные PR нагрузку не создают, свободных в результате нет
+	counts, err := b.Reviewers.OpenReviewCounts(ctx, []string{"u2", "u3", "u4"})
+	must(t, err)
+	equal(t, "OpenReviewCounts", counts, map[string]int{"u2": 2})
+
+	var locked []domain.PullRequest
+	must(t, b.Tx.InTx(ctx, func(tx service.Stores) error {
+		var err error
+		locked, err = tx.PRs.LockOpenReviewedBy(ctx, []string{"u2", "u3"})
+		return err
+	}))
+	ids := make([]string, 0, len(locked))
+	for _, pr := range locked {
+		ids = append(ids, pr.ID)
+	}
+	equal(t, "LockOpenReviewedBy", ids, []string{"pr-1", "pr-2"})
+}
+
+func testListReviewedBy(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-2", "Two", "u1", domain.PRStatusOpen))
+	must(t, b.PRs.Create(ctx, "pr-1", "One", "u1", domain.PRStatusOpen))
+	must(t, b.PRs.Create(ctx, "pr-3", "Three", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-2", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u3"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-3", "u3"))
+
+	_, err := b.PRs.MarkMerged(ctx, "pr-2")
+	must(t, err)
+
+	// Смерженные PR тоже попадают в список, порядок — по ID
+	prs, err := b.PRs.ListReviewedBy(ctx, "u2")
+	must(t, err)
+	ids := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		ids = append(ids, pr.ID)
+	}
+	equal(t, "ListReviewedBy", ids, []string{"pr-1", "pr-2"})
+	equal(t, "merged status", prs[1].Status, domain.PRStatusMerged)
+	if prs[0].CreatedAt.IsZero() {
+		t.Fatalf("PR has no created_at")
+	}
+
+	none, err := b.PRs.ListReviewedBy(ctx, "u4")
+	must(t, err)
+	equal(t, "no reviews", len(none), 0)
+}
+
+func testEventsKeepOrder(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+
+	must(t, b.Events.Append(ctx))
+	must(t, b.Events.Append(ctx,
+		domain.AssignmentEvent{PRID: "pr-1", Type: domain.EventAssigned, NewReviewerID: "u2", Actor: "system", Reason: domain.ReasonAuto},
+		domain.AssignmentEvent{PRID: "pr-1", Type: domain.EventReassigned, OldReviewerID: "u2", NewReviewerID: "u3", Actor: "alice", Reason: domain.ReasonManual},
+	))
+	must(t, b.Events.Append(ctx,
+		domain.AssignmentEvent{PRID: "pr-1", Type: domain.EventUnassigned, OldReviewerID: "u3", Actor: "system", Reason: domain.ReasonDeactivation},
+	))
+
+	events, err := b.Events.ListByPR(ctx, "pr-1")
+	must(t, err)
+	if len(events) != 3 {
+		t.Fatalf("ListByPR: got %d events, want 3", len(events))
+	}
+
+	types := []string{events[0].Type, events[1].Type, events[2].Type}
+	equal(t, "event order", types, []string{domain.EventAssigned, domain.EventReassigned, domain.EventUnassigned})
+	equal(t, "empty old reviewer", events[0].OldReviewerID, "")
+	equal(t, "empty new reviewer", events[2].NewReviewerID, "")
+	equal(t, "actor", events[1].Actor, "alice")
+	if events[0].CreatedAt.IsZero() {
+		t.Fatalf("event has no created_at")
+	}
+
+	none, err := b.Events.ListByPR(ctx, "nope")
+	must(t, err)
+	equal(t, "events of missing PR", len(none), 0)
+}
+
+func testTxRollback(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	boom := errors.New("boom")
+	err := b.Tx.InTx(ctx, func(tx service.Stores) error {
+		must(t, tx.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+		must(t, tx.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("InTx: got %v, want the error returned by fn", err)
+	}
+
+	pr, err := b.PRs.GetByID(ctx, "pr-1")
+	must(t, err)
+	if pr != nil {
+		t.Fatalf("PR survived rollback: %+v", pr)
+	}
+
+	must(t, b.Tx.InTx(ctx, func(tx service.Stores) error {
+		return tx.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen)
+	}))
+	pr, err = b.PRs.GetByID(ctx, "pr-1")
+	must(t, err)
+	if pr == nil {
+		t.Fatalf("committed PR not found")
+	}
+}
+
+// Чтение через хранилища вне транзакции, пока она открыта,
+// не должно ждать commit и не должно видеть незакоммиченное
+func testReadOutsideTx(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seed(t, b)
+
+	must(t, b.Tx.InTx(ctx, func(tx service.Stores) error {
+		must(t, tx.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+		must(t, tx.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+
+		type result struct {
+			counts map[string]int
+			err    error
+		}
+		done := make(chan result, 1)
+		go func() {
+			counts, err := b.Reviewers.OpenReviewCounts(ctx, []string{"u2"})
+			done <- result{counts, err}
+		}()
+
+		select {
+		case r := <-done:
+			must(t, r.err)
+			equal(t, "uncommitted load", r.counts["u2"], 0)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("read outside tx blocked by open tx")
+		}
+		return nil
+	}))
+
+	counts, err := b.Reviewers.OpenReviewCounts(ctx, []string{"u2"})
+	must(t, err)
+	equal(t, "committed load", counts["u2"], 1)
+}
+
+// seedHistory — PR с журналом назначений, как его пишут сервисы:
+// pr-1 (u1): u2 и u3, u2 заменён на u4 и возвращён обратно;
+// pr-2 (u1): u3, смержен; pr-3 (p1): u2 назначен и снят
+func seedHistory(t *testing.T, b Backend) {
+	t.Helper()
+	ctx := context.Background()
+	seed(t, b)
+
+	assigned := func(prID, id string) domain.AssignmentEvent {
+		return domain.AssignmentEvent{PRID: prID, Type: domain.EventAssigned, NewReviewerID: id, Actor: "system", Reason: domain.ReasonAuto}
+	}
+	reassigned := func(prID, oldID, newID string) domain.AssignmentEvent {
+		return domain.AssignmentEvent{PRID: prID, Type: domain.EventReassigned, OldReviewerID: oldID, NewReviewerID: newID, Actor: "alice", Reason: domain.ReasonManual}
+	}
+
+	must(t, b.PRs.Create(ctx, "pr-1", "Add search", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u2"))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-1", "u3"))
+	must(t, b.Events.Append(ctx, assigned("pr-1", "u2"), assigned("pr-1", "u3")))
+	replaced, err := b.Reviewers.ReplaceReviewer(ctx, "pr-1", "u2", "u4")
+	must(t, err)
+	equal(t, "replace", replaced, true)
+	must(t, b.Events.Append(ctx, reassigned("pr-1", "u2", "u4")))
+	replaced, err = b.Reviewers.ReplaceReviewer(ctx, "pr-1", "u4", "u2")
+	must(t, err)
+	equal(t, "replace back", replaced, true)
+	must(t, b.Events.Append(ctx, reassigned("pr-1", "u4", "u2")))
+
+	must(t, b.PRs.Create(ctx, "pr-2", "Fix login", "u1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-2", "u3"))
+	must(t, b.Events.Append(ctx, assigned("pr-2", "u3")))
+	_, err = b.PRs.MarkMerged(ctx, "pr-2")
+	must(t, err)
+
+	must(t, b.PRs.Create(ctx, "pr-3", "Refunds", "p1", domain.PRStatusOpen))
+	must(t, b.Reviewers.AssignReviewer(ctx, "pr-3", "u2"))
+	must(t, b.Events.Append(ctx, assigned("pr-3", "u2")))
+	removed, err := b.Reviewers.RemoveReviewer(ctx, "pr-3", "u2")
+	must(t, err)
+	equal(t, "remove", removed, true)
+	must(t, b.Events.Append(ctx, domain.AssignmentEvent{
+		PRID: "pr-3", Type: domain.EventUnassigned, OldReviewerID: "u2", Actor: "system", Reason: domain.ReasonDeactivation,
+	}))
+}
+
+// period — [from, to) вокруг текущего момента со сдвигами в часах
+func period(fromHours, toHours int) (*time.Time, *time.Time) {
+	now := time.Now()
+	from := now.Add(time.Duration(fromHours) * time.Hour)
+	to := now.Add(time.Duration(toHours) * time.Hour)
+	return &from, &to
+}
+
+func testUserStats(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seedHistory(t, b)
+
+	type row struct{ open, total, merged, away int }
+	collect := func(f domain.StatsFilter) map[string]row {
+		t.Helper()
+		stats, err := b.Stats.UserStats(ctx, f)
+		must(t, err)
+		result := make(map[string]row, len(stats))
+		for _, s := range stats {
+			result[s.UserID] = row{s.OpenReviews, s.TotalAssignments, s.MergedReviewed, s.ReassignedAway}
+		}
+		return result
+	}
+
+	// u2: назначен на pr-1, возвращён на pr-1 и назначен на pr-3 — три назначения, два снятия
+	all := map[string]row{
+		"u1": {},
+		"u2": {open: 1, total: 3, away: 2},
+		"u3": {open: 1, total: 2, merged: 1},
+		"u4": {total: 1, away: 1},
+		"p1": {},
+	}
+	equal(t, "all time", collect(domain.StatsFilter{}), all)
+
+	from, to := period(-1, 1)
+	equal(t, "period around now", collect(domain.StatsFilter{From: from, To: to}), all)
+
+	// Период в будущем: истории нет, но текущая нагрузка остаётся
+	from, to = period(1, 2)
+	equal(t, "future period", collect(domain.StatsFilter{From: from, To: to}), map[string]row{
+		"u1": {}, "u2": {open: 1}, "u3": {open: 1}, "u4": {}, "p1": {},
+	})
+
+	equal(t, "team filter", collect(domain.StatsFilter{TeamName: "payments"}), map[string]row{"p1": {}})
+}
+
+func testPRStats(t *testing.T, b Backend) {
+	ctx := context.Background()
+	seedHistory(t, b)
+
+	stats, err := b.Stats.PRStats(ctx, domain.StatsFilter{})
+	must(t, err)
+	if len(stats) != 3 {
+		t.Fatalf("PRStats: got %d rows, want 3", len(stats))
+	}
+
+	equal(t, "order", []string{stats[0].PRID, stats[1].PRID, stats[2].PRID}, []string{"pr-1", "pr-2", "pr-3"})
+	equal(t, "pr-1 reassignments", stats[0].Reassignments, 2)
+	equal(t, "pr-3 removals", stats[2].Reassignments, 1)
+	equal(t, "pr-3 team", stats[2].TeamName, "payments")
+	equal(t, "pr-2 status", stats[1].Status, domain.PRStatusMerged)
+	if stats[0].TimeToMerge != nil || stats[1].TimeToMerge == nil || *stats[1].TimeToMerge < 0 {
+		t.Fatalf("time to merge: open %v, merged %v", stats[0].TimeToMerge, stats[1].TimeToMerge)
+	}
+
+	backend, err := b.Stats.PRStats(ctx, domain.StatsFilter{TeamName: "backend"})
+	must(t, err)
+	equal(t, "team filter", len(backend), 2)
+
+	from, to := period(1, 2)
+	future, err := b.Stats.PRStats(ctx, domain.StatsFilter{From: from, To: to})
+	must(t, err)
+	equal(t, "future period", len(future), 0)
+}
@@ -1,36 +1,54 @@
 package storage
 
 import (
+	"avito-2025/internal/domain"
 	"context"
 	"database/sql"
-	"strconv"
 )
 
 type TeamRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewTeamRepository(db *sql.DB) *TeamRepository {
+func NewTeamRepository(db DBTX) *TeamRepository {
 	return &TeamRepository{db: db}
 }
 
-// Create — создать команду
+// WithTx — копия репозитория, работающая внутри транзакции tx
+func (r *TeamRepository) WithTx(tx *sql.Tx) *TeamRepository {
+	return &TeamRepository{db: tx}
+}
+
+// scanTeam — прочитать строку teams в domain.Team
+func scanTeam(row rowScanner) (domain.Team, error) {
+	var t domain.Team
+	var createdAt sql.NullTime
+
+	if err := row.Scan(&t.ID, &t.Name, &createdAt); err != nil {
+		return domain.Team{}, err
+	}
+
+	t.CreatedAt = timeOrZero(createdAt)
+	return t, nil
+}
+
+// Create — создать команду. Если команда уже есть, возвращает ErrAlreadyExists.
 func (r *TeamRepository) Create(ctx context.Context, teamName string) error {
-	query := `INSERT INTO teams (name, created_at) VALUES ($1, NOW())`
-	_, err := r.db.ExecContext(ctx, query, teamName)
-	return err
+	query := `INSERT INTO teams (name, created_at) VALUES ($1, NOW()) 
+	          ON CONFLICT (name) DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, query, teamName)
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
 }
 
 // GetByName — получить команду по имени (string)
-func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (map[string]interface{}, error) {
-	var id int
-	var name string
-	var createdAt interface{}
-
+func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
 	query := `SELECT id, name, created_at FROM teams WHERE name = $1`
-	err := r.db.QueryRowContext(ctx, query, teamName).
-		Scan(&id, &name, &createdAt)
 
+	t, err := scanTeam(r.db.QueryRowContext(ctx, query, teamName))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -38,14 +56,11 @@ func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (map[st
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"ID":   strconv.Itoa(id),
-		"Name": name,
-	}, nil
+	return &t, nil
 }
 
 // List — получить все команды
-func (r *TeamRepository) List(ctx context.Context) ([]map[string]interface{}, error) {
+func (r *TeamRepository) List(ctx context.Context) ([]domain.Team, error) {
 	query := `SELECT id, name, created_at FROM teams ORDER BY id`
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
@@ -53,20 +68,13 @@ func (r *TeamRepository) List(ctx context.Context) ([]map[string]interface{}, er
 	}
 	defer rows.Close()
 
-	var teams []map[string]interface{}
+	var teams []domain.Team
 	for rows.Next() {
-		var id int
-		var name string
-		var createdAt interface{}
-
-		if err := rows.Scan(&id, &name, &createdAt); err != nil {
+		t, err := scanTeam(rows)
+		if err != nil {
 			return nil, err
 		}
-
-		teams = append(teams, map[string]interface{}{
-			"ID":   strconv.Itoa(id),
-			"Name": name,
-		})
+		teams = append(teams, t)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -80,12 +88,13 @@ func (r *TeamRepository) List(ctx context.Context) ([]map[string]interface{}, er
 func (r *TeamRepository) Update(ctx context.Context, oldTeamName string, newTeamName string) error {
 	query := `UPDATE teams SET name = $1 WHERE name = $2`
 	_, err := r.db.ExecContext(ctx, query, newTeamName, oldTeamName)
-	return err
+	return translate(err)
 }
 
-// Delete — удалить команду по имени
+// Delete — удалить команду вместе с участниками (ON DELETE CASCADE). Если участник —
+// автор или ревьювер PR, внешние ключи не дают его удалить: storage.ErrReferenced
 func (r *TeamRepository) Delete(ctx context.Context, teamName string) error {
 	query := `DELETE FROM teams WHERE name = $1`
 	_, err := r.db.ExecContext(ctx, query, teamName)
-	return err
+	return translateDelete(err)
 }
@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// TxOptions — параметры транзакций TxManager
+type TxOptions struct {
+	// Isolation — уровень изоляции; sql.LevelDefault — уровень сервера (READ COMMITTED)
+	Isolation sql.IsolationLevel
+	// MaxRetries — сколько раз повторить транзакцию после конфликта сериализации
+	MaxRetries int
+	// RetryBackoff — базовая пауза перед повтором, растёт линейно со случайной добавкой
+	RetryBackoff time.Duration
+}
+
+// DefaultTxOptions — изоляция сервера и три повтора
+var DefaultTxOptions = TxOptions{
+	Isolation:    sql.LevelDefault,
+	MaxRetries:   3,
+	RetryBackoff: 10 * time.Millisecond,
+}
+
+// ParseIsolation — уровень изоляции по имени: read_committed, repeatable_read, serializable
+func ParseIsolation(name string) (sql.IsolationLevel, error) {
+	switch strings.ToLower(strings.ReplaceAll(name, " ", "_")) {
+	case "", "default":
+		return sql.LevelDefault, nil
+	case "read_committed":
+		return sql.LevelReadCommitted, nil
+	case "repeatable_read":
+		return sql.LevelRepeatableRead, nil
+	case "serializable":
+		return sql.LevelSerializable, nil
+	default:
+		return 0, fmt.Errorf("unknown isolation level %q", name)
+	}
+}
+
+// UnitOfWork — репозитории, работающие в одной транзакции
+type UnitOfWork struct {
+	Tx        *sql.Tx
+	Users     *UserRepository
+	Teams     *TeamRepository
+	PRs       *PRRepository
+	Reviewers *PRReviewerRepository
+	Events    *AssignmentEventRepository
+}
+
+func newUnitOfWork(tx *sql.Tx) *UnitOfWork {
+	return &UnitOfWork{
+		Tx:        tx,
+		Users:     NewUserRepository(tx),
+		Teams:     NewTeamRepository(tx),
+		PRs:       NewPRRepository(tx),
+		Reviewers: NewPRReviewerRepository(tx),
+		Events:    NewAssignmentEventRepository(tx),
+	}
+}
+
+// TxManager запускает единицы работы в транзакции: commit при успехе, rollback при ошибке.
+// При конфликте сериализации (SQLSTATE 40001) транзакция повторяется целиком,
+// поэтому fn не должна иметь побочных эффектов вне транзакции.
+type TxManager struct {
+	db   *sql.DB
+	opts TxOptions
+}
+
+func NewTxManager(db *sql.DB, opts TxOptions) *TxManager {
+	return &TxManager{db: db, opts: opts}
+}
+
+// Do — выполнить fn в транзакции с настройками менеджера
+func (m *TxManager) Do(ctx context.Context, fn func(uow *UnitOfWork) error) error {
+	return m.DoWith(ctx, m.opts.Isolation, fn)
+}
+
+// DoWith — выполнить fn в транзакции с заданным уровнем изоляции
+func (m *TxManager) DoWith(ctx context.Context, isolation sql.IsolationLevel, fn func(uow *UnitOfWork) error) error {
+	for attempt := 0; ; attempt++ {
+		err := m.run(ctx, isolation, fn)
+		if err == nil || !IsSerializationFailure(err) || attempt >= m.opts.MaxRetries {
+			return err
+		}
+
+		// Пауза со случайной добавкой, чтобы конкуренты не столкнулись снова
+		backoff := m.opts.RetryBackoff * time.Duration(attempt+1)
+		if backoff > 0 {
+			backoff += time.Duration(rand.Int63n(int64(backoff)))
+		}
+		slog.WarnContext(ctx, "Конфликт сериализации, транзакция будет повторена",
+			"attempt", attempt+1, "max_retries", m.opts.MaxRetries, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (m *TxManager) run(ctx context.Context, isolation sql.IsolationLevel, fn func(uow *UnitOfWork) error) error {
+	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{Isolation: isolation})
+	if err != nil {
+		return err
+	}
+
+	if err := fn(newUnitOfWork(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// IsSerializationFailure — ошибка Postgres 40001: транзакцию можно безопасно повторить
+func IsSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "40001"
+}
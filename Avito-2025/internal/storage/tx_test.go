@@ -0,0 +1,168 @@
+package storage_test
+
+import (
+	"avito-2025/internal/storage"
+	"avito-2025/internal/storage/sqlite"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var errSerialization = &pq.Error{Code: "40001", Message: "could not serialize access"}
+
+// newTxManager — TxManager поверх SQLite: повторы и откат не зависят от СУБД,
+// а ошибку 40001 подставляет сам тест
+func newTxManager(t *testing.T, maxRetries int, backoff time.Duration) (*storage.TxManager, *sql.DB) {
+	t.Helper()
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "tx.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec(`CREATE TABLE attempts (n INTEGER NOT NULL)`); err != nil {
+		t.Fatal(err)
+	}
+	return storage.NewTxManager(db, storage.TxOptions{MaxRetries: maxRetries, RetryBackoff: backoff}), db
+}
+
+// failFirst — fn, которая пишет строку и падает с err первые n попыток
+func failFirst(n int, err error, attempts *int) func(uow *storage.UnitOfWork) error {
+	return func(uow *storage.UnitOfWork) error {
+		*attempts++
+		if _, e := uow.Tx.Exec(`INSERT INTO attempts (n) VALUES (?)`, *attempts); e != nil {
+			return e
+		}
+		if *attempts <= n {
+			return err
+		}
+		return nil
+	}
+}
+
+func committedAttempts(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+	rows, err := db.Query(`SELECT n FROM attempts ORDER BY n`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var result []int
+	for rows.Next() {
+		var n int
+		if err := rows.Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, n)
+	}
+	return result
+}
+
+func TestTxManagerRetries(t *testing.T) {
+	cases := []struct {
+		name      string
+		failures  int
+		err       error
+		wantErr   error
+		attempts  int
+		committed []int
+	}{
+		{"success", 0, nil, nil, 1, []int{1}},
+		{"retried until success", 2, errSerialization, nil, 3, []int{3}},
+		{"wrapped serialization failure", 1, fmt.Errorf("reassign: %w", errSerialization), nil, 2, []int{2}},
+		{"retry cap", 10, errSerialization, errSerialization, 4, nil},
+		{"other errors are not retried", 10, storage.ErrNotFound, storage.ErrNotFound, 1, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, db := newTxManager(t, 3, time.Millisecond)
+
+			attempts := 0
+			err := m.Do(context.Background(), failFirst(c.failures, c.err, &attempts))
+			if !errors.Is(err, c.wantErr) || (c.wantErr == nil && err != nil) {
+				t.Fatalf("got %v, want %v", err, c.wantErr)
+			}
+			if attempts != c.attempts {
+				t.Fatalf("attempts: got %d, want %d", attempts, c.attempts)
+			}
+
+			// Неудачные попытки откатываются целиком
+			if got := committedAttempts(t, db); fmt.Sprint(got) != fmt.Sprint(c.committed) {
+				t.Fatalf("committed: got %v, want %v", got, c.committed)
+			}
+		})
+	}
+}
+
+// Отмена контекста во время паузы перед повтором прерывает цикл
+func TestTxManagerStopsOnCancel(t *testing.T) {
+	m, db := newTxManager(t, 5, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := 0
+	err := m.Do(ctx, func(uow *storage.UnitOfWork) error {
+		attempts++
+		cancel()
+		return errSerialization
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts: got %d, want 1", attempts)
+	}
+	if got := committedAttempts(t, db); len(got) != 0 {
+		t.Fatalf("committed: %v", got)
+	}
+}
+
+func TestIsSerializationFailure(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{errSerialization, true},
+		{fmt.Errorf("tx: %w", errSerialization), true},
+		{&pq.Error{Code: "40P01"}, false},
+		{&pq.Error{Code: "23505"}, false},
+		{errors.New("40001"), false},
+		{nil, false},
+	}
+
+	for _, c := range cases {
+		if got := storage.IsSerializationFailure(c.err); got != c.want {
+			t.Errorf("%v: got %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestParseIsolation(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    sql.IsolationLevel
+		wantErr bool
+	}{
+		{"", sql.LevelDefault, false},
+		{"default", sql.LevelDefault, false},
+		{"read_committed", sql.LevelReadCommitted, false},
+		{"REPEATABLE READ", sql.LevelRepeatableRead, false},
+		{"serializable", sql.LevelSerializable, false},
+		{"snapshot", 0, true},
+	}
+
+	for _, c := range cases {
+		got, err := storage.ParseIsolation(c.in)
+		if (err != nil) != c.wantErr || got != c.want {
+			t.Errorf("%q: got %v, %v; want %v, error %v", c.in, got, err, c.want, c.wantErr)
+		}
+	}
+}
@@ -1,46 +1,61 @@
 package storage
 
 import (
+	"avito-2025/internal/domain"
 	"context"
 	"database/sql"
-	"strconv"
+	"sort"
+
+	"github.com/lib/pq"
 )
 
 type UserRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
-func NewUserRepository(db *sql.DB) *UserRepository {
+func NewUserRepository(db DBTX) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-// Create — создать пользователя
-func (r *UserRepository) Create(ctx context.Context, username string, teamName string, isActive bool) (string, error) {
-	var id int
-	query := `INSERT INTO users (username, team_name, is_active, created_at) 
-	          VALUES ($1, $2, $3, NOW()) 
-	          RETURNING id`
+// WithTx — копия репозитория, работающая внутри транзакции tx
+func (r *UserRepository) WithTx(tx *sql.Tx) *UserRepository {
+	return &UserRepository{db: tx}
+}
 
-	err := r.db.QueryRowContext(ctx, query, username, teamName, isActive).Scan(&id)
-	if err != nil {
-		return "", err
+const userColumns = `user_id, username, team_name, is_active, created_at, updated_at`
+
+// scanUser — прочитать строку users в domain.User
+func scanUser(row rowScanner) (domain.User, error) {
+	var u domain.User
+	var createdAt, updatedAt sql.NullTime
+
+	if err := row.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive, &createdAt, &updatedAt); err != nil {
+		return domain.User{}, err
 	}
-	return strconv.Itoa(id), nil
+
+	u.CreatedAt = timeOrZero(createdAt)
+	u.UpdatedAt = timeOrZero(updatedAt)
+	return u, nil
 }
 
-// GetByID — получить пользователя по string ID
-func (r *UserRepository) GetByID(ctx context.Context, userID string) (map[string]interface{}, error) {
-	var id int
-	var username, teamName string
-	var isActive bool
-	var createdAt interface{}
+// Create — создать пользователя с внешним ID, выбранным клиентом
+func (r *UserRepository) Create(ctx context.Context, userID string, username string, teamName string, isActive bool) error {
+	query := `INSERT INTO users (user_id, username, team_name, is_active, created_at) 
+	          VALUES ($1, $2, $3, $4, NOW()) 
+	          ON CONFLICT (user_id) DO NOTHING`
 
-	query := `SELECT id, username, team_name, is_active, created_at 
-	          FROM users WHERE id = $1`
+	res, err := r.db.ExecContext(ctx, query, userID, username, teamName, isActive)
+	if err != nil {
+		return translate(err)
+	}
+	return expectInserted(res)
+}
 
-	err := r.db.QueryRowContext(ctx, query, userID).
-		Scan(&id, &username, &teamName, &isActive, &createdAt)
+// GetByID — получить пользователя по string ID
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE user_id = $1`
 
+	u, err := scanUser(r.db.QueryRowContext(ctx, query, userID))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -48,42 +63,82 @@ func (r *UserRepository) GetByID(ctx context.Context, userID string) (map[string
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"ID":       strconv.Itoa(id),
-		"Username": username,
-		"TeamName": teamName,
-		"IsActive": isActive,
-	}, nil
+	return &u, nil
+}
+
+// Upsert — создать пользователя или обновить имя, команду и активность существующего
+func (r *UserRepository) Upsert(ctx context.Context, userID string, username string, teamName string, isActive bool) error {
+	query := `INSERT INTO users (user_id, username, team_name, is_active, created_at, updated_at) 
+	          VALUES ($1, $2, $3, $4, NOW(), NOW()) 
+	          ON CONFLICT (user_id) DO UPDATE 
+	          SET username = EXCLUDED.username, team_name = EXCLUDED.team_name, 
+	              is_active = EXCLUDED.is_active, updated_at = NOW()`
+
+	_, err := r.db.ExecContext(ctx, query, userID, username, teamName, isActive)
+	return translate(err)
+}
+
+// GetByTeam — получить всех членов команды, включая неактивных
+func (r *UserRepository) GetByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
+	query := `SELECT ` + userColumns + ` 
+	          FROM users WHERE team_name = $1 ORDER BY user_id`
+	return r.queryUsers(ctx, query, teamName)
 }
 
 // GetActiveMembers — получить активных членов команды по имени команды
-func (r *UserRepository) GetActiveMembers(ctx context.Context, teamName string) ([]map[string]interface{}, error) {
-	query := `SELECT id, username, team_name, is_active, created_at 
+func (r *UserRepository) GetActiveMembers(ctx context.Context, teamName string) ([]domain.User, error) {
+	query := `SELECT ` + userColumns + ` 
 	          FROM users WHERE team_name = $1 AND is_active = TRUE`
 
-	rows, err := r.db.QueryContext(ctx, query, teamName)
+	return r.queryUsers(ctx, query, teamName)
+}
+
+// DeactivateMembers — пометить неактивными участников команды.
+// Если userIDs == nil, деактивируется вся команда. Возвращает ID затронутых.
+func (r *UserRepository) DeactivateMembers(ctx context.Context, teamName string, userIDs []string) ([]string, error) {
+	query := `UPDATE users SET is_active = FALSE, updated_at = NOW() 
+	          WHERE team_name = $1 AND ($2::varchar[] IS NULL OR user_id = ANY($2)) 
+	          RETURNING user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, teamName, pq.Array(userIDs))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var users []map[string]interface{}
+	ids := make([]string, 0)
 	for rows.Next() {
-		var id int
-		var username, teamName string
-		var isActive bool
-		var createdAt interface{}
-
-		if err := rows.Scan(&id, &username, &teamName, &isActive, &createdAt); err != nil {
+		var id string
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING не гарантирует порядок
+	sort.Strings(ids)
+	return ids, nil
+}
 
-		users = append(users, map[string]interface{}{
-			"ID":       strconv.Itoa(id),
-			"Username": username,
-			"TeamName": teamName,
-			"IsActive": isActive,
-		})
+// queryUsers — выполнить запрос и прочитать список пользователей
+func (r *UserRepository) queryUsers(ctx context.Context, query string, args ...interface{}) ([]domain.User, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -95,14 +150,14 @@ func (r *UserRepository) GetActiveMembers(ctx context.Context, teamName string)
 
 // Update — обновить пользователя
 func (r *UserRepository) Update(ctx context.Context, userID string, username string, isActive bool) error {
-	query := `UPDATE users SET username=$1, is_active=$2, updated_at=NOW() WHERE id=$3`
+	query := `UPDATE users SET username=$1, is_active=$2, updated_at=NOW() WHERE user_id=$3`
 	_, err := r.db.ExecContext(ctx, query, username, isActive, userID)
-	return err
+	return translate(err)
 }
 
 // Delete — удалить пользователя
 func (r *UserRepository) Delete(ctx context.Context, userID string) error {
-	query := `DELETE FROM users WHERE id=$1`
+	query := `DELETE FROM users WHERE user_id=$1`
 	_, err := r.db.ExecContext(ctx, query, userID)
 	return err
 }
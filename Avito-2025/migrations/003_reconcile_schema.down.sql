@@ -0,0 +1,28 @@
+-- pr_reviewers: обратно на внутренний ID PR
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_pr_id_fkey;
+ALTER TABLE pr_reviewers ADD COLUMN pr_id_int INT;
+UPDATE pr_reviewers r SET pr_id_int = p.id FROM pull_requests p WHERE p.pull_request_id = r.pr_id;
+ALTER TABLE pr_reviewers DROP COLUMN pr_id;
+ALTER TABLE pr_reviewers RENAME COLUMN pr_id_int TO pr_id;
+ALTER TABLE pr_reviewers ALTER COLUMN pr_id SET NOT NULL;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pr_id_fkey
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(id) ON DELETE CASCADE;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pr_id_reviewer_id_key UNIQUE (pr_id, reviewer_id);
+CREATE INDEX idx_pr_reviewers_pr_id ON pr_reviewers(pr_id);
+
+-- pull_requests
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pull_request_id_key;
+ALTER TABLE pull_requests DROP COLUMN pull_request_id;
+ALTER TABLE pull_requests DROP COLUMN merged_at;
+ALTER TABLE pull_requests RENAME COLUMN name TO title;
+
+-- users
+DROP INDEX IF EXISTS idx_users_team_name;
+ALTER TABLE users DROP COLUMN updated_at;
+ALTER TABLE users ADD COLUMN team_id INT;
+UPDATE users u SET team_id = t.id FROM teams t WHERE t.name = u.team_name;
+ALTER TABLE users ALTER COLUMN team_id SET NOT NULL;
+ALTER TABLE users ADD CONSTRAINT users_team_id_fkey
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE users DROP COLUMN team_name;
+CREATE INDEX idx_users_team_id ON users(team_id);
@@ -0,0 +1,23 @@
+-- users: команда хранится по имени, добавляем время обновления
+ALTER TABLE users ADD COLUMN team_name VARCHAR(255);
+UPDATE users u SET team_name = t.name FROM teams t WHERE t.id = u.team_id;
+ALTER TABLE users ALTER COLUMN team_name SET NOT NULL;
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE users DROP COLUMN team_id;
+ALTER TABLE users ADD COLUMN updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+CREATE INDEX idx_users_team_name ON users(team_name);
+
+-- pull_requests: name вместо title, время merge и внешний строковый ID
+ALTER TABLE pull_requests RENAME COLUMN title TO name;
+ALTER TABLE pull_requests ADD COLUMN merged_at TIMESTAMP;
+ALTER TABLE pull_requests ADD COLUMN pull_request_id VARCHAR(255);
+UPDATE pull_requests SET pull_request_id = id::text;
+ALTER TABLE pull_requests ALTER COLUMN pull_request_id SET NOT NULL;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_pull_request_id_key UNIQUE (pull_request_id);
+
+-- pr_reviewers: ссылаемся на внешний ID PR
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_pr_id_fkey;
+ALTER TABLE pr_reviewers ALTER COLUMN pr_id TYPE VARCHAR(255) USING pr_id::text;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pr_id_fkey
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE;
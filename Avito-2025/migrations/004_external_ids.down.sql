@@ -0,0 +1,18 @@
+-- pr_reviewers.reviewer_id обратно на внутренний ID
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_reviewer_id_fkey;
+UPDATE pr_reviewers r SET reviewer_id = u.id::text FROM users u WHERE u.user_id = r.reviewer_id;
+ALTER TABLE pr_reviewers ALTER COLUMN reviewer_id TYPE INT USING reviewer_id::int;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_reviewer_id_fkey
+    FOREIGN KEY (reviewer_id) REFERENCES users(id);
+
+-- pull_requests.author_id обратно на внутренний ID
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_author_id_fkey;
+UPDATE pull_requests p SET author_id = u.id::text FROM users u WHERE u.user_id = p.author_id;
+ALTER TABLE pull_requests ALTER COLUMN author_id TYPE INT USING author_id::int;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES users(id);
+
+-- users
+ALTER TABLE users ADD CONSTRAINT users_username_key UNIQUE (username);
+ALTER TABLE users DROP CONSTRAINT users_user_id_key;
+ALTER TABLE users DROP COLUMN user_id;
@@ -0,0 +1,20 @@
+-- users: внешний строковый ID, который выбирает клиент (u1, u2, ...)
+ALTER TABLE users ADD COLUMN user_id VARCHAR(255);
+UPDATE users SET user_id = id::text;
+ALTER TABLE users ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE users ADD CONSTRAINT users_user_id_key UNIQUE (user_id);
+
+-- Пользователь идентифицируется по user_id, имена могут повторяться
+ALTER TABLE users DROP CONSTRAINT users_username_key;
+
+-- pull_requests.author_id ссылается на внешний ID пользователя
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_author_id_fkey;
+ALTER TABLE pull_requests ALTER COLUMN author_id TYPE VARCHAR(255) USING author_id::text;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES users(user_id) ON UPDATE CASCADE;
+
+-- pr_reviewers.reviewer_id ссылается на внешний ID пользователя
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_reviewer_id_fkey;
+ALTER TABLE pr_reviewers ALTER COLUMN reviewer_id TYPE VARCHAR(255) USING reviewer_id::text;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_reviewer_id_fkey
+    FOREIGN KEY (reviewer_id) REFERENCES users(user_id) ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_assigned_at;
+DROP TABLE IF EXISTS reviewer_reassignments;
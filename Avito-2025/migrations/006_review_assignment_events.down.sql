@@ -0,0 +1,33 @@
+DROP TRIGGER review_assignment_events_no_change ON review_assignment_events;
+DROP FUNCTION review_assignment_events_append_only();
+
+-- В журнале переназначений остаются только замены и снятия для существующих PR и пользователей
+DELETE FROM review_assignment_events e
+WHERE e.event_type = 'assigned'
+   OR NOT EXISTS (SELECT 1 FROM pull_requests p WHERE p.pull_request_id = e.pr_id)
+   OR NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = e.old_reviewer_id)
+   OR (e.new_reviewer_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users u WHERE u.user_id = e.new_reviewer_id));
+
+DROP INDEX idx_review_assignment_events_pr_id;
+CREATE INDEX idx_reviewer_reassignments_pr_id ON review_assignment_events(pr_id);
+ALTER INDEX idx_review_assignment_events_old_reviewer_id RENAME TO idx_reviewer_reassignments_old_reviewer_id;
+
+-- Вместе с колонками удаляются и их CHECK-ограничения
+ALTER TABLE review_assignment_events
+    DROP COLUMN event_type,
+    DROP COLUMN actor,
+    DROP COLUMN reason,
+    ALTER COLUMN old_reviewer_id SET NOT NULL,
+    ALTER COLUMN id TYPE INT,
+    ADD CONSTRAINT reviewer_reassignments_pr_id_fkey
+        FOREIGN KEY (pr_id) REFERENCES pull_requests(pull_request_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    ADD CONSTRAINT reviewer_reassignments_old_reviewer_id_fkey
+        FOREIGN KEY (old_reviewer_id) REFERENCES users(user_id) ON UPDATE CASCADE,
+    ADD CONSTRAINT reviewer_reassignments_new_reviewer_id_fkey
+        FOREIGN KEY (new_reviewer_id) REFERENCES users(user_id) ON UPDATE CASCADE;
+
+ALTER SEQUENCE review_assignment_events_id_seq AS INT;
+ALTER SEQUENCE review_assignment_events_id_seq RENAME TO reviewer_reassignments_id_seq;
+ALTER TABLE review_assignment_events RENAME COLUMN created_at TO reassigned_at;
+ALTER TABLE review_assignment_events RENAME CONSTRAINT review_assignment_events_pkey TO reviewer_reassignments_pkey;
+ALTER TABLE review_assignment_events RENAME TO reviewer_reassignments;
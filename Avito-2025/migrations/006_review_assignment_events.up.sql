@@ -0,0 +1,59 @@
+-- Журнал переназначений (005) становится журналом всех событий назначения ревьюверов.
+-- Только дописывается: история должна пережить удаление пользователей, поэтому внешних ключей нет
+ALTER TABLE reviewer_reassignments RENAME TO review_assignment_events;
+ALTER TABLE review_assignment_events RENAME CONSTRAINT reviewer_reassignments_pkey TO review_assignment_events_pkey;
+ALTER TABLE review_assignment_events RENAME COLUMN reassigned_at TO created_at;
+ALTER SEQUENCE reviewer_reassignments_id_seq RENAME TO review_assignment_events_id_seq;
+ALTER SEQUENCE review_assignment_events_id_seq AS BIGINT;
+
+ALTER TABLE review_assignment_events
+    DROP CONSTRAINT reviewer_reassignments_pr_id_fkey,
+    DROP CONSTRAINT reviewer_reassignments_old_reviewer_id_fkey,
+    DROP CONSTRAINT reviewer_reassignments_new_reviewer_id_fkey,
+    ALTER COLUMN id TYPE BIGINT,
+    ALTER COLUMN old_reviewer_id DROP NOT NULL,
+    ADD COLUMN event_type VARCHAR(20),
+    ADD COLUMN actor VARCHAR(255),
+    ADD COLUMN reason VARCHAR(20);
+
+-- Записи журнала переназначений: замены без нового ревьювера были снятиями при деактивации
+UPDATE review_assignment_events
+SET event_type = CASE WHEN new_reviewer_id IS NULL THEN 'unassigned' ELSE 'reassigned' END,
+    actor = 'system',
+    reason = CASE WHEN new_reviewer_id IS NULL THEN 'deactivation' ELSE 'manual' END;
+
+ALTER TABLE review_assignment_events
+    ALTER COLUMN event_type SET NOT NULL,
+    ALTER COLUMN actor SET NOT NULL,
+    ALTER COLUMN reason SET NOT NULL,
+    ADD CHECK (event_type IN ('assigned', 'unassigned', 'reassigned')),
+    ADD CHECK (reason IN ('auto', 'manual', 'deactivation')),
+    ADD CHECK (
+        (event_type = 'assigned' AND old_reviewer_id IS NULL AND new_reviewer_id IS NOT NULL) OR
+        (event_type = 'unassigned' AND old_reviewer_id IS NOT NULL AND new_reviewer_id IS NULL) OR
+        (event_type = 'reassigned' AND old_reviewer_id IS NOT NULL AND new_reviewer_id IS NOT NULL)
+    );
+
+DROP INDEX idx_reviewer_reassignments_pr_id;
+CREATE INDEX idx_review_assignment_events_pr_id ON review_assignment_events(pr_id, id);
+ALTER INDEX idx_reviewer_reassignments_old_reviewer_id RENAME TO idx_review_assignment_events_old_reviewer_id;
+
+-- Текущие назначения, которые не появились через переназначение, считаем автоматическими
+INSERT INTO review_assignment_events (pr_id, event_type, new_reviewer_id, actor, reason, created_at)
+SELECT r.pr_id, 'assigned', r.reviewer_id, 'system', 'auto', COALESCE(r.assigned_at, CURRENT_TIMESTAMP)
+FROM pr_reviewers r
+WHERE NOT EXISTS (
+    SELECT 1 FROM review_assignment_events e
+    WHERE e.event_type = 'reassigned' AND e.pr_id = r.pr_id AND e.new_reviewer_id = r.reviewer_id
+)
+ORDER BY r.assigned_at, r.id;
+
+CREATE FUNCTION review_assignment_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'review_assignment_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_assignment_events_no_change
+    BEFORE UPDATE OR DELETE ON review_assignment_events
+    FOR EACH ROW EXECUTE FUNCTION review_assignment_events_append_only();